@@ -0,0 +1,248 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// catalogCache memoizes the read-only Vultr catalogs (plans, regions,
+// operating systems, applications) for the lifetime of a provider client so
+// data sources and plan time validation share a single fetch of each list.
+type catalogCache struct {
+	disabled bool
+
+	mu      sync.Mutex
+	entries map[string]*catalogEntry
+}
+
+type catalogEntry struct {
+	ready chan struct{}
+	value interface{}
+	err   error
+}
+
+func newCatalogCache(disabled bool) *catalogCache {
+	return &catalogCache{disabled: disabled, entries: map[string]*catalogEntry{}}
+}
+
+// load returns the cached value for key, calling fetch at most once across
+// concurrent callers. Failed fetches are not cached so the next caller
+// retries them.
+func (c *catalogCache) load(ctx context.Context, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil || c.disabled {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-e.ready:
+			return e.value, e.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	e := &catalogEntry{ready: make(chan struct{})}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	e.value, e.err = fetch()
+	if e.err != nil {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+	}
+	close(e.ready)
+
+	return e.value, e.err
+}
+
+// plans returns every cloud compute plan
+func (c *Client) plans(ctx context.Context) ([]govultr.Plan, error) {
+	v, err := c.catalog.load(ctx, "plans", func() (interface{}, error) {
+		var planList []govultr.Plan
+		options := &govultr.ListOptions{}
+
+		for {
+			plans, meta, _, err := c.client.Plan.List(ctx, "", options)
+			if err != nil {
+				return nil, fmt.Errorf("error getting plans: %v", err)
+			}
+			planList = append(planList, plans...)
+
+			if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+				return planList, nil
+			}
+			options.Cursor = meta.Links.Next
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]govultr.Plan), nil
+}
+
+// bareMetalPlans returns every bare metal plan
+func (c *Client) bareMetalPlans(ctx context.Context) ([]govultr.BareMetalPlan, error) {
+	v, err := c.catalog.load(ctx, "plans_metal", func() (interface{}, error) {
+		var planList []govultr.BareMetalPlan
+		options := &govultr.ListOptions{}
+
+		for {
+			plans, meta, _, err := c.client.Plan.ListBareMetal(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("error getting bare metal plans: %v", err)
+			}
+			planList = append(planList, plans...)
+
+			if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+				return planList, nil
+			}
+			options.Cursor = meta.Links.Next
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]govultr.BareMetalPlan), nil
+}
+
+// regions returns every region
+func (c *Client) regions(ctx context.Context) ([]govultr.Region, error) {
+	v, err := c.catalog.load(ctx, "regions", func() (interface{}, error) {
+		var regionList []govultr.Region
+		options := &govultr.ListOptions{}
+
+		for {
+			regions, meta, _, err := c.client.Region.List(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("error getting regions: %v", err)
+			}
+			regionList = append(regionList, regions...)
+
+			if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+				return regionList, nil
+			}
+			options.Cursor = meta.Links.Next
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]govultr.Region), nil
+}
+
+// operatingSystems returns every operating system
+func (c *Client) operatingSystems(ctx context.Context) ([]govultr.OS, error) {
+	v, err := c.catalog.load(ctx, "os", func() (interface{}, error) {
+		var osList []govultr.OS
+		options := &govultr.ListOptions{}
+
+		for {
+			os, meta, _, err := c.client.OS.List(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("error getting os list: %v", err)
+			}
+			osList = append(osList, os...)
+
+			if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+				return osList, nil
+			}
+			options.Cursor = meta.Links.Next
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]govultr.OS), nil
+}
+
+// applications returns every marketplace and one-click application
+func (c *Client) applications(ctx context.Context) ([]govultr.Application, error) {
+	v, err := c.catalog.load(ctx, "applications", func() (interface{}, error) {
+		var appList []govultr.Application
+		options := &govultr.ListOptions{}
+
+		for {
+			apps, meta, _, err := c.client.Application.List(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("error getting applications: %v", err)
+			}
+			appList = append(appList, apps...)
+
+			if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+				return appList, nil
+			}
+			options.Cursor = meta.Links.Next
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]govultr.Application), nil
+}
+
+// databasePlans returns every managed database plan
+func (c *Client) databasePlans(ctx context.Context) ([]govultr.DatabasePlan, error) {
+	v, err := c.catalog.load(ctx, "database_plans", func() (interface{}, error) {
+		plans, _, _, err := c.client.Database.ListPlans(ctx, &govultr.DBPlanListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting database plans: %v", err)
+		}
+		return plans, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]govultr.DatabasePlan), nil
+}
+
+var errNoDatabaseOfVersion = errors.New("no database runs the engine version")
+
+// databaseEngineAdvancedOptions returns the advanced options the API offers
+// for an engine version, as listed by an existing database running that
+// version. The API has no catalog of them, so ok is false when the account
+// has no such database.
+func (c *Client) databaseEngineAdvancedOptions(ctx context.Context, engine, version string) (options []govultr.AvailableOption, ok bool, err error) { //nolint:lll
+	v, err := c.catalog.load(ctx, "database_advanced_options_"+engine+"_"+version, func() (interface{}, error) {
+		databases, err := listDatabases(ctx, c.client)
+		if err != nil {
+			return nil, fmt.Errorf("error getting databases: %v", err)
+		}
+
+		for i := range databases {
+			if databases[i].DatabaseEngine != engine || databases[i].DatabaseEngineVersion != version {
+				continue
+			}
+
+			options, err := getDatabaseAdvancedOptions(ctx, c.client, databases[i].ID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting advanced options of database %s : %v", databases[i].ID, err)
+			}
+			return options.AvailableOptions, nil
+		}
+
+		// not cached, so a database created later is found
+		return nil, errNoDatabaseOfVersion
+	})
+	if errors.Is(err, errNoDatabaseOfVersion) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	return v.([]govultr.AvailableOption), true, nil
+}
@@ -0,0 +1,130 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCatalogCacheLoad(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("concurrent callers share one fetch", func(t *testing.T) {
+		c := newCatalogCache(false)
+		var calls int32
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := c.load(ctx, "plans", func() (interface{}, error) {
+					atomic.AddInt32(&calls, 1)
+					time.Sleep(10 * time.Millisecond)
+					return "value", nil
+				})
+				if err != nil || v != "value" {
+					t.Errorf("load = %v, %v", v, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if calls != 1 {
+			t.Fatalf("fetch called %d times, want 1", calls)
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		c := newCatalogCache(false)
+		calls := 0
+		fetch := func() (interface{}, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("boom")
+			}
+			return "value", nil
+		}
+
+		if _, err := c.load(ctx, "regions", fetch); err == nil {
+			t.Fatal("expected the first load to fail")
+		}
+		if v, err := c.load(ctx, "regions", fetch); err != nil || v != "value" {
+			t.Fatalf("load = %v, %v", v, err)
+		}
+		if _, err := c.load(ctx, "regions", fetch); err != nil || calls != 2 {
+			t.Fatalf("fetch called %d times, want 2", calls)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := newCatalogCache(true)
+		calls := 0
+		for i := 0; i < 3; i++ {
+			if _, err := c.load(ctx, "os", func() (interface{}, error) {
+				calls++
+				return "value", nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if calls != 3 {
+			t.Fatalf("fetch called %d times, want 3", calls)
+		}
+	})
+}
+
+func TestCatalogCacheSharedAcrossLookups(t *testing.T) {
+	for _, disabled := range []bool{false, true} {
+		api := newMockVultrAPI(t)
+		config := Config{APIKey: mockAPIKey, APIEndpoint: api.URL, RetryLimit: 1, DisableCatalogCache: disabled}
+		client, err := config.Client()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			ds := dataSourceVultrPlan()
+			d := ds.TestResourceData()
+			if err := d.Set("filter", []interface{}{
+				map[string]interface{}{"name": "id", "values": []interface{}{"vc2-1c-1gb"}},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("plan data source: %v", diags)
+			}
+			if d.Id() != "vc2-1c-1gb" {
+				t.Fatalf("plan data source id = %q", d.Id())
+			}
+		}
+
+		want := 1
+		if disabled {
+			want = 2
+		}
+		if got := api.requestCount(http.MethodGet, "/v2/plans"); got != want {
+			t.Errorf("disabled=%t: GET /v2/plans requested %d times, want %d", disabled, got, want)
+		}
+
+		raw := map[string]interface{}{"plan": "vc2-1c-1gb", "region": "ewr", "os_id": 1743}
+		if _, err := resourceVultrInstance().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), client); err != nil { //nolint:lll
+			t.Fatalf("instance diff: %v", err)
+		}
+
+		got := api.requestCount(http.MethodGet, "/v2/plans")
+		if !disabled && got != 1 {
+			t.Errorf("plan validation refetched the cached plans, GET /v2/plans requested %d times", got)
+		}
+		if disabled && got <= want {
+			t.Errorf("plan validation used a disabled cache, GET /v2/plans requested %d times", got)
+		}
+	}
+}
@@ -2,7 +2,11 @@ package vultr
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
+	"os"
 	"runtime/debug"
 	"time"
 
@@ -15,14 +19,21 @@ const terraformSDKPath string = "github.com/hashicorp/terraform-plugin-sdk/v2"
 
 // Config is the configuration structure used to instantiate Vultr
 type Config struct {
-	APIKey     string
-	RateLimit  int
-	RetryLimit int
+	APIKey      string
+	APIEndpoint string
+	CACertFile  string
+	RateLimit   int
+	RetryLimit  int
+	DefaultTags []string
+
+	DisableCatalogCache bool
 }
 
 // Client wraps govultr
 type Client struct {
-	client *govultr.Client
+	client      *govultr.Client
+	defaultTags []string
+	catalog     *catalogCache
 }
 
 func (c *Client) govultrClient() *govultr.Client {
@@ -53,12 +64,27 @@ func (c *Config) Client() (*Client, error) {
 		AccessToken: c.APIKey,
 	})
 
-	client := oauth2.NewClient(context.Background(), tokenSrc)
+	ctx := context.Background()
+	if c.CACertFile != "" {
+		transport, err := caCertTransport(c.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: transport})
+	}
+
+	client := oauth2.NewClient(ctx, tokenSrc)
 	client.Transport = logging.NewSubsystemLoggingHTTPTransport("Vultr", client.Transport)
 
 	vultrClient := govultr.NewClient(client)
 	vultrClient.SetUserAgent(userAgent)
 
+	if c.APIEndpoint != "" {
+		if err := vultrClient.SetBaseURL(c.APIEndpoint); err != nil {
+			return nil, fmt.Errorf("invalid API endpoint %q: %v", c.APIEndpoint, err)
+		}
+	}
+
 	if c.RateLimit != 0 {
 		vultrClient.SetRateLimit(time.Duration(c.RateLimit) * time.Millisecond)
 	}
@@ -67,5 +93,35 @@ func (c *Config) Client() (*Client, error) {
 		vultrClient.SetRetryLimit(c.RetryLimit)
 	}
 
-	return &Client{client: vultrClient}, nil
+	return &Client{
+		client:      vultrClient,
+		defaultTags: c.DefaultTags,
+		catalog:     newCatalogCache(c.DisableCatalogCache),
+	}, nil
+}
+
+// caCertTransport returns an HTTP transport that trusts the certificates in
+// the PEM bundle at path on top of the system roots
+func caCertTransport(path string) (*http.Transport, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA certificate file %q: %v", path, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificates found in CA certificate file %q", path)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	return transport, nil
 }
@@ -14,6 +14,8 @@ func dataSourceVultrApplication() *schema.Resource {
 		ReadContext: dataSourceVultrApplicationRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"deploy_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,7 +45,7 @@ func dataSourceVultrApplication() *schema.Resource {
 }
 
 func dataSourceVultrApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client).govultrClient()
+	client := meta.(*Client)
 
 	filters, filtersOk := d.GetOk("filter")
 
@@ -52,35 +54,34 @@ func dataSourceVultrApplicationRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	appList := []govultr.Application{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-
-	for {
-		apps, meta, _, err := client.Application.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting applications: %v", err)
-		}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, a := range apps {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
+	apps, err := client.applications(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, a := range apps {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
-			if filterLoop(f, sm) {
-				appList = append(appList, a)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			appList = append(appList, a)
 		}
 	}
+
+	appList, err = dataSourceSelect(d, appList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(appList) > 1 {
 		return diag.Errorf(
 			"your search returned too many results : %d. Please refine your search to be more specific",
@@ -32,7 +32,11 @@ func dataSourceVultrBackupRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	var backupList []map[string]interface{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
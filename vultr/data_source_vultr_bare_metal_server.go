@@ -12,7 +12,10 @@ func dataSourceVultrBareMetalServer() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBareMetalServerRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"os": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -130,7 +133,11 @@ func dataSourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	serverList := []govultr.BareMetalServer{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -159,6 +166,11 @@ func dataSourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceD
 			continue
 		}
 	}
+	serverList, err = dataSourceSelect(d, serverList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(serverList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
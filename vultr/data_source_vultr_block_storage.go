@@ -12,7 +12,10 @@ func dataSourceVultrBlockStorage() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBlockStorageRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,7 +90,11 @@ func dataSourceVultrBlockStorageRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	var blockList []govultr.BlockStorage
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 	for {
 		block, meta, _, err := client.BlockStorage.List(ctx, options)
@@ -114,6 +121,11 @@ func dataSourceVultrBlockStorageRead(ctx context.Context, d *schema.ResourceData
 			continue
 		}
 	}
+	blockList, err = dataSourceSelect(d, blockList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(blockList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -0,0 +1,84 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrBlockStorages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrBlockStoragesRead,
+		Schema: map[string]*schema.Schema{
+			"filter":         dataSourceFiltersSchema(),
+			"sort":           dataSourceSortSchema(),
+			"limit":          dataSourceLimitSchema(),
+			"block_storages": dataSourceListSchema(dataSourceVultrBlockStorage()),
+		},
+	}
+}
+
+func dataSourceVultrBlockStoragesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	blockList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		blocks, meta, _, err := client.BlockStorage.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting block storages: %v", err)
+		}
+
+		for i := range blocks {
+			ok, err := filterMatches(f, blocks[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				blockList = append(blockList, map[string]interface{}{
+					"id":                         blocks[i].ID,
+					"date_created":               blocks[i].DateCreated,
+					"cost":                       blocks[i].Cost,
+					"pending_charges":            blocks[i].PendingCharges,
+					"status":                     blocks[i].Status,
+					"size_gb":                    blocks[i].SizeGB,
+					"region":                     blocks[i].Region,
+					"attached_to_instance":       blocks[i].AttachedToInstance,
+					"attached_to_instance_ip":    blocks[i].AttachedToInstanceIP,
+					"attached_to_instance_label": blocks[i].AttachedToInstanceLabel,
+					"label":                      blocks[i].Label,
+					"mount_id":                   blocks[i].MountID,
+					"block_type":                 blocks[i].BlockType,
+					"os_id":                      blocks[i].OSID,
+					"snapshot_id":                blocks[i].SnapshotID,
+					"bootable":                   blocks[i].Bootable,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	blockList, err = dataSourceListSelect(d, blockList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("block_storages")
+	if err := d.Set("block_storages", blockList); err != nil {
+		return diag.Errorf("error setting `block_storages`: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrBlockStorages(t *testing.T) {
+	t.Parallel()
+	rLabel := acctest.RandomWithPrefix("tf-bs-ds")
+	name := "data.vultr_block_storages.block"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrBlockStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrBlockStoragesConfig(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "block_storages.#", "2"),
+					resource.TestCheckResourceAttr(name, "block_storages.0.label", rLabel),
+					resource.TestCheckResourceAttr(name, "block_storages.0.region", "ewr"),
+					resource.TestCheckResourceAttrSet(name, "block_storages.0.id"),
+					resource.TestCheckResourceAttrSet(name, "block_storages.0.size_gb"),
+					resource.TestCheckResourceAttrSet(name, "block_storages.1.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrBlockStoragesConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_block_storage" "foo" {
+			count = 2
+			region = "ewr"
+			size_gb = 10
+			label = "%s"
+		}
+
+		data "vultr_block_storages" "block" {
+			filter {
+				name = "label"
+				values = [vultr_block_storage.foo[0].label]
+			}
+
+			depends_on = [vultr_block_storage.foo]
+		}
+		`, label)
+}
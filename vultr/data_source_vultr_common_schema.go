@@ -1,19 +1,48 @@
 package vultr
 
 import (
+	"cmp"
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Filter match_by operators. Without one, scalar attributes must equal one of
+// the values and list attributes must contain all of them.
+const (
+	matchExact   = "exact"
+	matchRegex   = "regex"
+	matchPrefix  = "prefix"
+	matchNot     = "not"
+	matchLT      = "lt"
+	matchLTE     = "lte"
+	matchGT      = "gt"
+	matchGTE     = "gte"
+	matchBetween = "between"
+	matchAny     = "any"
+	matchAll     = "all"
+)
+
+var filterMatchBy = []string{
+	matchExact, matchRegex, matchPrefix, matchNot, matchLT, matchLTE, matchGT, matchGTE, matchBetween, matchAny, matchAll,
+}
+
 type filter struct {
-	name   string
-	values []string
+	name    string
+	values  []string
+	matchBy string
+
+	patterns []*regexp.Regexp
+	bounds   []float64
 }
 
-func buildVultrDataSourceFilter(set *schema.Set) []filter {
+func buildVultrDataSourceFilter(set *schema.Set) ([]filter, error) {
 	var filters []filter
 
 	for _, v := range set.List() {
@@ -22,13 +51,61 @@ func buildVultrDataSourceFilter(set *schema.Set) []filter {
 		for _, value := range m["values"].([]interface{}) {
 			values = append(values, value.(string))
 		}
-		filters = append(filters, filter{
-			name:   m["name"].(string),
-			values: values,
-		})
+
+		f := filter{
+			name:    m["name"].(string),
+			values:  values,
+			matchBy: matchExact,
+		}
+		if matchBy, ok := m["match_by"].(string); ok && matchBy != "" {
+			f.matchBy = matchBy
+		}
+
+		if err := f.compile(); err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	return filters, nil
+}
+
+// compile parses the values of regex and numeric filters up front so a bad
+// value is reported instead of silently matching nothing
+func (f *filter) compile() error {
+	switch f.matchBy {
+	case matchRegex:
+		for _, v := range f.values {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return fmt.Errorf("filter %q: invalid regex %q: %v", f.name, v, err)
+			}
+			f.patterns = append(f.patterns, re)
+		}
+	case matchLT, matchLTE, matchGT, matchGTE, matchBetween:
+		want := 1
+		if f.matchBy == matchBetween {
+			want = 2
+		}
+		if len(f.values) != want {
+			return fmt.Errorf("filter %q: match_by %q takes %d value(s), got %d", f.name, f.matchBy, want, len(f.values))
+		}
+
+		for _, v := range f.values {
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("filter %q: match_by %q needs numeric values, got %q", f.name, f.matchBy, v)
+			}
+			f.bounds = append(f.bounds, n)
+		}
+
+		if f.matchBy == matchBetween && f.bounds[0] > f.bounds[1] {
+			return fmt.Errorf("filter %q: between lower bound %v is greater than upper bound %v",
+				f.name, f.bounds[0], f.bounds[1])
+		}
 	}
 
-	return filters
+	return nil
 }
 
 func structToMap(data interface{}) (map[string]interface{}, error) {
@@ -64,13 +141,88 @@ func structToMap(data interface{}) (map[string]interface{}, error) {
 
 func filterLoop(f []filter, m map[string]interface{}) bool {
 	for _, filter := range f {
-		if !valuesLoop(filter.values, m[filter.name]) {
+		if !filter.matches(m[filter.name]) {
 			return false
 		}
 	}
 	return true
 }
 
+// matches reports whether an attribute value, as flattened by structToMap,
+// satisfies the filter
+func (f *filter) matches(actual interface{}) bool {
+	list, isList := actual.([]interface{})
+
+	switch f.matchBy {
+	case matchAny:
+		if isList {
+			return slices.ContainsFunc(list, func(v interface{}) bool { return slices.Contains(f.values, fmt.Sprint(v)) })
+		}
+		return valuesLoop(f.values, actual)
+	case matchAll:
+		if isList {
+			return valuesLoop(f.values, actual)
+		}
+		for _, v := range f.values {
+			if actual != v {
+				return false
+			}
+		}
+		return true
+	case matchNot:
+		if isList {
+			return !slices.ContainsFunc(list, func(v interface{}) bool { return slices.Contains(f.values, fmt.Sprint(v)) })
+		}
+		return !valuesLoop(f.values, actual)
+	case matchRegex, matchPrefix:
+		if isList {
+			return slices.ContainsFunc(list, f.matchesString)
+		}
+		return f.matchesString(actual)
+	case matchLT, matchLTE, matchGT, matchGTE, matchBetween:
+		return !isList && f.matchesNumber(actual)
+	default:
+		return valuesLoop(f.values, actual)
+	}
+}
+
+func (f *filter) matchesString(actual interface{}) bool {
+	if actual == nil {
+		return false
+	}
+	s := fmt.Sprint(actual)
+
+	if f.matchBy == matchRegex {
+		return slices.ContainsFunc(f.patterns, func(re *regexp.Regexp) bool { return re.MatchString(s) })
+	}
+	return slices.ContainsFunc(f.values, func(prefix string) bool { return strings.HasPrefix(s, prefix) })
+}
+
+func (f *filter) matchesNumber(actual interface{}) bool {
+	s, ok := actual.(string)
+	if !ok {
+		return false
+	}
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return false
+	}
+
+	switch f.matchBy {
+	case matchLT:
+		return n < f.bounds[0]
+	case matchLTE:
+		return n <= f.bounds[0]
+	case matchGT:
+		return n > f.bounds[0]
+	case matchGTE:
+		return n >= f.bounds[0]
+	default:
+		return n >= f.bounds[0] && n <= f.bounds[1]
+	}
+}
+
 func valuesLoop(values []string, actual interface{}) bool {
 	switch a := actual.(type) {
 	case []interface{}:
@@ -117,7 +269,251 @@ func dataSourceFiltersSchema() *schema.Schema {
 					Required: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
+
+				"match_by": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      matchExact,
+					ValidateFunc: validation.StringInSlice(filterMatchBy, false),
+				},
+			},
+		},
+	}
+}
+
+// dataSourceSearchKeys are the arguments of a singular data source that pick
+// the item rather than describe it
+var dataSourceSearchKeys = []string{"filter", "sort", "first", "most_recent"}
+
+// dataSourceListSchema returns the computed list of a plural data source. Its
+// elements carry the attributes of the singular data source item, less any
+// excluded keys, so the two stay in step.
+func dataSourceListSchema(item *schema.Resource, exclude ...string) *schema.Schema {
+	elem := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	for k, v := range item.Schema {
+		if slices.Contains(dataSourceSearchKeys, k) || slices.Contains(exclude, k) {
+			continue
+		}
+
+		attr := *v
+		attr.Required = false
+		attr.Optional = false
+		attr.Computed = true
+		attr.ForceNew = false
+		attr.Default = nil
+		attr.DefaultFunc = nil
+		attr.ValidateFunc = nil
+		attr.ValidateDiagFunc = nil
+		elem[k] = &attr
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: elem},
+	}
+}
+
+// dataSourceFilters returns the filters of a plural data source. Without a
+// filter block every item matches.
+func dataSourceFilters(d *schema.ResourceData) ([]filter, error) {
+	filters, ok := d.GetOk("filter")
+	if !ok {
+		return nil, nil
+	}
+
+	return buildVultrDataSourceFilter(filters.(*schema.Set))
+}
+
+// filterMatches reports whether an API object matches every filter
+func filterMatches(f []filter, item interface{}) (bool, error) {
+	sm, err := structToMap(item)
+	if err != nil {
+		return false, err
+	}
+
+	return filterLoop(f, sm), nil
+}
+
+// Sort directions of a data source sort block
+const (
+	sortAsc  = "asc"
+	sortDesc = "desc"
+)
+
+func dataSourceSortSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"direction": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      sortAsc,
+					ValidateFunc: validation.StringInSlice([]string{sortAsc, sortDesc}, false),
+				},
 			},
 		},
 	}
 }
+
+func dataSourceMostRecentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+func dataSourceFirstSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+func dataSourceLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+type sortKey struct {
+	attribute string
+	desc      bool
+}
+
+// dataSourceSortKeys returns the sort blocks of a data source, with any
+// leading keys given ahead of them
+func dataSourceSortKeys(d *schema.ResourceData, leading ...sortKey) []sortKey {
+	keys := leading
+	for _, v := range d.Get("sort").([]interface{}) {
+		s := v.(map[string]interface{})
+		keys = append(keys, sortKey{attribute: s["attribute"].(string), desc: s["direction"].(string) == sortDesc})
+	}
+
+	return keys
+}
+
+// dataSourceSelect orders the items matched by a singular data source filter
+// by its sort blocks. With most_recent the newest item by date_created is
+// kept, with first the leading item, so that a search matching several items
+// resolves to one instead of failing.
+func dataSourceSelect[T any](d *schema.ResourceData, items []T) ([]T, error) {
+	var leading []sortKey
+	mostRecent, _ := d.Get("most_recent").(bool)
+	if mostRecent {
+		leading = append(leading, sortKey{attribute: "date_created", desc: true})
+	}
+
+	items, err := sortItems(items, dataSourceSortKeys(d, leading...))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 1 && (mostRecent || d.Get("first").(bool)) {
+		return items[:1], nil
+	}
+
+	return items, nil
+}
+
+// dataSourceListSelect orders the flattened items of a plural data source by
+// its sort blocks and keeps at most limit of them
+func dataSourceListSelect(d *schema.ResourceData, items []interface{}) ([]interface{}, error) {
+	items, err := sortItems(items, dataSourceSortKeys(d))
+	if err != nil {
+		return nil, err
+	}
+
+	if limit := d.Get("limit").(int); limit > 0 && len(items) > limit {
+		return items[:limit], nil
+	}
+
+	return items, nil
+}
+
+// sortItems returns the items stably ordered by the keys, which name
+// attributes as flattened by structToMap
+func sortItems[T any](items []T, keys []sortKey) ([]T, error) {
+	if len(items) < 2 || len(keys) == 0 {
+		return items, nil
+	}
+
+	maps := make([]map[string]interface{}, len(items))
+	for i := range items {
+		m, err := structToMap(items[i])
+		if err != nil {
+			return nil, err
+		}
+		maps[i] = m
+	}
+
+	for _, k := range keys {
+		if !slices.ContainsFunc(maps, func(m map[string]interface{}) bool { return m[k.attribute] != nil }) {
+			return nil, fmt.Errorf("unable to sort by %q: no result has that attribute", k.attribute)
+		}
+	}
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		for _, k := range keys {
+			if c := compareSortValues(maps[a][k.attribute], maps[b][k.attribute], k.desc); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	sorted := make([]T, len(items))
+	for i, idx := range order {
+		sorted[i] = items[idx]
+	}
+
+	return sorted, nil
+}
+
+// compareSortValues orders two attribute values as flattened by structToMap,
+// numerically when both are numbers. Missing values sort last either way.
+func compareSortValues(a, b interface{}, desc bool) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	var c int
+	as, bs := fmt.Sprint(a), fmt.Sprint(b)
+	af, aErr := strconv.ParseFloat(as, 64)
+	bf, bErr := strconv.ParseFloat(bs, 64)
+	if aErr == nil && bErr == nil {
+		c = cmp.Compare(af, bf)
+	} else {
+		c = strings.Compare(as, bs)
+	}
+
+	if desc {
+		return -c
+	}
+	return c
+}
@@ -0,0 +1,380 @@
+package vultr
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceListSchema(t *testing.T) {
+	list := dataSourceListSchema(dataSourceVultrKubernetes(), "kube_config")
+	elem := list.Elem.(*schema.Resource).Schema
+
+	if !list.Computed || list.Type != schema.TypeList {
+		t.Fatalf("list schema must be a computed list")
+	}
+	for _, k := range []string{"filter", "sort", "first", "most_recent", "kube_config"} {
+		if _, ok := elem[k]; ok {
+			t.Errorf("%s should not be part of the list element", k)
+		}
+	}
+	for _, k := range []string{"id", "label", "node_pools"} {
+		if _, ok := elem[k]; !ok {
+			t.Errorf("%s should be part of the list element", k)
+		}
+	}
+
+	// the lookup argument of a singular data source becomes a computed attribute
+	domain := dataSourceListSchema(dataSourceVultrDNSDomain()).Elem.(*schema.Resource).Schema["domain"]
+	if domain.Required || !domain.Computed || domain.ValidateFunc != nil {
+		t.Errorf("domain should be computed only, got %#v", domain)
+	}
+
+	r := &schema.Resource{Schema: map[string]*schema.Schema{"items": list}}
+	if err := r.InternalValidate(nil, false); err != nil {
+		t.Fatalf("list schema is invalid: %v", err)
+	}
+}
+
+// most_recent picks by date_created, so only data sources whose results have
+// one may offer it
+func TestDataSourceMostRecentNeedsDateCreated(t *testing.T) {
+	for name, ds := range Provider().DataSourcesMap {
+		if _, ok := ds.Schema["most_recent"]; !ok {
+			continue
+		}
+		if _, ok := ds.Schema["date_created"]; !ok {
+			t.Errorf("%s accepts most_recent without a date_created attribute", name)
+		}
+	}
+}
+
+func TestDataSourceVultrListFilters(t *testing.T) {
+	api := newMockVultrAPI(t)
+	client := api.client(t)
+
+	api.put("/v2/blocks", "b1", map[string]interface{}{"id": "b1", "label": "web", "region": "ewr", "size_gb": 10})
+	api.put("/v2/blocks", "b2", map[string]interface{}{"id": "b2", "label": "web", "region": "ams", "size_gb": 20})
+	api.put("/v2/blocks", "b3", map[string]interface{}{"id": "b3", "label": "db", "region": "ewr", "size_gb": 40})
+	api.put("/v2/domains", "a.example.com", map[string]interface{}{"domain": "a.example.com", "dns_sec": "disabled"})
+	api.put("/v2/domains", "b.example.com", map[string]interface{}{"domain": "b.example.com", "dns_sec": "enabled"})
+	api.put("/v2/reserved-ips", "r1", map[string]interface{}{"id": "r1", "region": "ewr", "ip_type": "v4", "label": "lb"})
+	api.put("/v2/snapshots", "s1", map[string]interface{}{"id": "s1", "description": "nightly", "status": "complete"})
+	api.put("/v2/ssh-keys", "k1", map[string]interface{}{"id": "k1", "name": "ops", "ssh_key": "ssh-ed25519 AAAA"})
+	api.put("/v2/vpcs", "v1", map[string]interface{}{"id": "v1", "region": "ewr", "description": "private"})
+	api.put("/v2/vpc2", "v2", map[string]interface{}{"id": "v2", "region": "ewr", "description": "private"})
+	api.put("/v2/load-balancers", "l1", map[string]interface{}{
+		"id": "l1", "label": "edge", "region": "ewr", "status": "active",
+		"generic_info": map[string]interface{}{"balancing_algorithm": "roundrobin"},
+		"health_check": map[string]interface{}{"protocol": "http", "port": 80},
+		"forwarding_rules": []interface{}{
+			map[string]interface{}{"id": "f1", "frontend_protocol": "http", "frontend_port": 80},
+		},
+	})
+	api.put("/v2/databases", "d1", map[string]interface{}{
+		"id": "d1", "label": "pg", "region": "ewr", "database_engine": "pg", "plan_replicas": 1,
+		"backup_hour": "1", "backup_minute": "30",
+		"trusted_ips": []interface{}{"192.0.2.1"},
+	})
+	api.put("/v2/kubernetes/clusters", "c1", map[string]interface{}{
+		"id": "c1", "label": "vke", "region": "ewr",
+		"node_pools": []interface{}{map[string]interface{}{"id": "p1", "label": "pool", "node_quantity": 1}},
+	})
+
+	tests := []struct {
+		dataSource string
+		filter     []interface{}
+		sort       []interface{}
+		limit      int
+		want       []string
+	}{
+		{dataSource: "vultr_block_storages", want: []string{"b1", "b2", "b3"}},
+		{
+			dataSource: "vultr_block_storages",
+			filter: []interface{}{
+				map[string]interface{}{"name": "label", "values": []interface{}{"web"}},
+				map[string]interface{}{"name": "region", "values": []interface{}{"ewr", "ams"}},
+			},
+			want: []string{"b1", "b2"},
+		},
+		{
+			dataSource: "vultr_block_storages",
+			filter:     []interface{}{map[string]interface{}{"name": "label", "values": []interface{}{"cache"}}},
+		},
+		{
+			dataSource: "vultr_block_storages",
+			sort:       []interface{}{map[string]interface{}{"attribute": "size_gb", "direction": "desc"}},
+			limit:      2,
+			want:       []string{"b3", "b2"},
+		},
+		{
+			dataSource: "vultr_dns_domains",
+			filter:     []interface{}{map[string]interface{}{"name": "dns_sec", "values": []interface{}{"enabled"}}},
+			want:       []string{"b.example.com"},
+		},
+		{dataSource: "vultr_reserved_ips", want: []string{"r1"}},
+		{dataSource: "vultr_snapshots", want: []string{"s1"}},
+		{dataSource: "vultr_ssh_keys", want: []string{"k1"}},
+		{dataSource: "vultr_vpcs", want: []string{"v1"}},
+		{dataSource: "vultr_vpc2s", want: []string{"v2"}},
+		{dataSource: "vultr_load_balancers", want: []string{"l1"}},
+		{dataSource: "vultr_databases", want: []string{"d1"}},
+		{dataSource: "vultr_kubernetes_clusters", want: []string{"c1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataSource, func(t *testing.T) {
+			ds := Provider().DataSourcesMap[tt.dataSource]
+			d := ds.TestResourceData()
+			if tt.filter != nil {
+				if err := d.Set("filter", tt.filter); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := d.Set("sort", tt.sort); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Set("limit", tt.limit); err != nil {
+				t.Fatal(err)
+			}
+
+			if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+
+			key := tt.dataSource[len("vultr_"):]
+			items := d.Get(key).([]interface{})
+			if len(items) != len(tt.want) {
+				t.Fatalf("got %d %s, want %d", len(items), key, len(tt.want))
+			}
+			for i, item := range items {
+				if id := item.(map[string]interface{})["id"]; id != tt.want[i] {
+					t.Errorf("%s.%d.id = %v, want %s", key, i, id, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFilterMatchBy(t *testing.T) {
+	plan, err := structToMap(map[string]interface{}{
+		"id":           "vc2-2c-4gb",
+		"ram":          4096,
+		"monthly_cost": 20.5,
+		"locations":    []string{"ewr", "ams"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		attr    string
+		matchBy string
+		values  []string
+		want    bool
+	}{
+		{"exact", "id", "exact", []string{"vc2-1c-1gb", "vc2-2c-4gb"}, true},
+		{"exact miss", "id", "exact", []string{"vc2-1c-1gb"}, false},
+		{"regex", "id", "regex", []string{`^vc2-\d+c-4gb$`}, true},
+		{"regex miss", "id", "regex", []string{`^vhf-`}, false},
+		{"prefix", "id", "prefix", []string{"vhf-", "vc2-"}, true},
+		{"not", "id", "not", []string{"vc2-1c-1gb"}, true},
+		{"not miss", "id", "not", []string{"vc2-2c-4gb"}, false},
+		{"lt", "ram", "lt", []string{"8192"}, true},
+		{"lte", "ram", "lte", []string{"4096"}, true},
+		{"gt", "ram", "gt", []string{"4096"}, false},
+		{"gte", "ram", "gte", []string{"4096"}, true},
+		{"between float", "monthly_cost", "between", []string{"10", "20.5"}, true},
+		{"between miss", "monthly_cost", "between", []string{"0", "20"}, false},
+		{"numeric on non numeric", "id", "gt", []string{"1"}, false},
+		{"numeric on missing", "disk", "gt", []string{"1"}, false},
+		{"list default requires all", "locations", "exact", []string{"ewr", "sea"}, false},
+		{"list all", "locations", "all", []string{"ewr", "ams"}, true},
+		{"list any", "locations", "any", []string{"sea", "ams"}, true},
+		{"list any miss", "locations", "any", []string{"sea"}, false},
+		{"list not", "locations", "not", []string{"sea"}, true},
+		{"list not miss", "locations", "not", []string{"sea", "ewr"}, false},
+		{"list regex", "locations", "regex", []string{"^a"}, true},
+		{"list prefix miss", "locations", "prefix", []string{"s"}, false},
+		{"list numeric", "locations", "gt", []string{"1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := filter{name: tt.attr, values: tt.values, matchBy: tt.matchBy}
+			if err := f.compile(); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := filterLoop([]filter{f}, plan); got != tt.want {
+				t.Errorf("match = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildVultrDataSourceFilterErrors(t *testing.T) {
+	tests := []struct {
+		matchBy string
+		values  []interface{}
+		wantErr string
+	}{
+		{"regex", []interface{}{"("}, "invalid regex"},
+		{"gt", []interface{}{"1", "2"}, "takes 1 value(s), got 2"},
+		{"between", []interface{}{"1"}, "takes 2 value(s), got 1"},
+		{"lt", []interface{}{"eight"}, "needs numeric values"},
+		{"between", []interface{}{"9", "1"}, "greater than upper bound"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.matchBy, func(t *testing.T) {
+			set := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), []interface{}{
+				map[string]interface{}{"name": "ram", "values": tt.values, "match_by": tt.matchBy},
+			})
+
+			_, err := buildVultrDataSourceFilter(set)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDataSourceVultrPlanMatchBy(t *testing.T) {
+	api := newMockVultrAPI(t)
+	client := api.client(t)
+
+	ds := dataSourceVultrPlan()
+	d := ds.TestResourceData()
+	if err := d.Set("filter", []interface{}{
+		map[string]interface{}{"name": "ram", "values": []interface{}{"4096"}, "match_by": "gte"},
+		map[string]interface{}{"name": "locations", "values": []interface{}{"sea", "ams"}, "match_by": "any"},
+		map[string]interface{}{"name": "id", "values": []interface{}{"^vhf-"}, "match_by": "regex"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	if d.Id() != "vhf-2c-4gb" {
+		t.Fatalf("id = %q, want vhf-2c-4gb", d.Id())
+	}
+}
+
+func TestDataSourceSelect(t *testing.T) {
+	type item struct {
+		ID          string  `json:"id"`
+		DateCreated string  `json:"date_created,omitempty"`
+		Cost        float32 `json:"cost"`
+		Label       string  `json:"label,omitempty"`
+	}
+	items := []item{
+		{ID: "a", DateCreated: "2024-03-01T10:00:00+00:00", Cost: 10, Label: "web"},
+		{ID: "b", DateCreated: "2024-05-01T10:00:00+00:00", Cost: 2.5},
+		{ID: "c", DateCreated: "2024-04-01T10:00:00+00:00", Cost: 10, Label: "db"},
+	}
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		want    []string
+		wantErr string
+	}{
+		{name: "unsorted", raw: map[string]interface{}{}, want: []string{"a", "b", "c"}},
+		{
+			name: "numeric asc",
+			raw:  map[string]interface{}{"sort": []interface{}{map[string]interface{}{"attribute": "cost"}}},
+			want: []string{"b", "a", "c"},
+		},
+		{
+			name: "tie breaker",
+			raw: map[string]interface{}{"sort": []interface{}{
+				map[string]interface{}{"attribute": "cost", "direction": "desc"},
+				map[string]interface{}{"attribute": "label"},
+			}},
+			want: []string{"c", "a", "b"},
+		},
+		{
+			name: "missing values last",
+			raw: map[string]interface{}{"sort": []interface{}{
+				map[string]interface{}{"attribute": "label", "direction": "desc"},
+			}},
+			want: []string{"a", "c", "b"},
+		},
+		{
+			name: "first",
+			raw: map[string]interface{}{
+				"sort":  []interface{}{map[string]interface{}{"attribute": "cost"}},
+				"first": true,
+			},
+			want: []string{"b"},
+		},
+		{name: "most recent", raw: map[string]interface{}{"most_recent": true}, want: []string{"b"}},
+		{
+			name:    "unknown attribute",
+			raw:     map[string]interface{}{"sort": []interface{}{map[string]interface{}{"attribute": "size"}}},
+			wantErr: `unable to sort by "size"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceVultrSnapshot().Schema, tt.raw)
+
+			got, err := dataSourceSelect(d, items)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ids := make([]string, len(got))
+			for i := range got {
+				ids[i] = got[i].ID
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataSourceVultrPlanCheapest(t *testing.T) {
+	api := newMockVultrAPI(t)
+	client := api.client(t)
+
+	ds := dataSourceVultrPlan()
+	d := ds.TestResourceData()
+	if err := d.Set("filter", []interface{}{
+		map[string]interface{}{"name": "ram", "values": []interface{}{"4096"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := ds.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatalf("expected an error for several matching plans")
+	}
+
+	sortBy := []interface{}{map[string]interface{}{"attribute": "monthly_cost", "direction": "asc"}}
+	if err := d.Set("sort", sortBy); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set("first", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	if d.Id() != "vc2-2c-4gb" {
+		t.Fatalf("id = %q, want vc2-2c-4gb", d.Id())
+	}
+}
@@ -12,7 +12,10 @@ func dataSourceVultrContainerRegistry() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrContainerRegistryRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,7 +61,11 @@ func dataSourceVultrContainerRegistryRead(ctx context.Context, d *schema.Resourc
 	}
 
 	crList := []govultr.ContainerRegistry{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{PerPage: 10}
 
 	for {
@@ -87,6 +94,11 @@ func dataSourceVultrContainerRegistryRead(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	crList, err = dataSourceSelect(d, crList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(crList) > 1 {
 		return diag.Errorf(
 			"your search returned too many results : %d. Please refine your search to be more specific",
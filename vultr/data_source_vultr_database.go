@@ -13,7 +13,10 @@ func dataSourceVultrDatabase() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrDatabaseRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -210,7 +213,11 @@ func dataSourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var databaseList []govultr.Database
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.DBListOptions{}
 	databases, _, _, err := client.Database.List(ctx, options)
 	if err != nil {
@@ -230,6 +237,11 @@ func dataSourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	databaseList, err = dataSourceSelect(d, databaseList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(databaseList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
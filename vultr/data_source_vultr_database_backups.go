@@ -0,0 +1,92 @@
+package vultr
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// dataSourceVultrDatabaseBackups reports the restore points of a managed
+// database. A backup restore uses the latest backup, and a point in time
+// restore can go back to any time between the oldest and latest backups.
+func dataSourceVultrDatabaseBackups() *schema.Resource {
+	backupSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabaseBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"latest_backup": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     backupSchema,
+			},
+			"oldest_backup": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     backupSchema,
+			},
+		},
+	}
+}
+
+func dataSourceVultrDatabaseBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	databaseID := d.Get("database_id").(string)
+
+	backups, _, err := client.Database.GetBackupInformation(ctx, databaseID)
+	if err != nil {
+		return diag.Errorf("error getting backups of database %s : %v", databaseID, err)
+	}
+
+	d.SetId(databaseID)
+	if err := d.Set("latest_backup", flattenDatabaseBackup(backups.LatestBackup)); err != nil {
+		return diag.Errorf("unable to set database_backups `latest_backup` read value: %v", err)
+	}
+	if err := d.Set("oldest_backup", flattenDatabaseBackup(backups.OldestBackup)); err != nil {
+		return diag.Errorf("unable to set database_backups `oldest_backup` read value: %v", err)
+	}
+
+	return nil
+}
+
+func flattenDatabaseBackup(backup govultr.DatabaseBackup) []map[string]interface{} {
+	if backup.Date == "" {
+		return nil
+	}
+
+	timestamp := ""
+	if at, err := parseDatabaseBackupTime(backup); err == nil {
+		timestamp = at.Format(time.RFC3339)
+	}
+
+	return []map[string]interface{}{{"date": backup.Date, "time": backup.Time, "timestamp": timestamp}}
+}
+
+// parseDatabaseBackupTime reads the UTC date and time of a backup
+func parseDatabaseBackupTime(backup govultr.DatabaseBackup) (time.Time, error) {
+	return time.Parse("2006-01-02 15:04:05", backup.Date+" "+backup.Time)
+}
@@ -0,0 +1,145 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrDatabases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabasesRead,
+		Schema: map[string]*schema.Schema{
+			"filter":    dataSourceFiltersSchema(),
+			"sort":      dataSourceSortSchema(),
+			"limit":     dataSourceLimitSchema(),
+			"databases": dataSourceListSchema(dataSourceVultrDatabase()),
+		},
+	}
+}
+
+func dataSourceVultrDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	databases, _, _, err := client.Database.List(ctx, &govultr.DBListOptions{})
+	if err != nil {
+		return diag.Errorf("error getting databases: %v", err)
+	}
+
+	databaseList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for i := range databases {
+		ok, err := filterMatches(f, databases[i])
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if ok {
+			databaseList = append(databaseList, flattenDatabase(&databases[i]))
+		}
+	}
+
+	databaseList, err = dataSourceListSelect(d, databaseList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("databases")
+	if err := d.Set("databases", databaseList); err != nil {
+		return diag.Errorf("error setting `databases`: %#v", err)
+	}
+
+	return nil
+}
+
+// flattenDatabase returns the attributes the vultr_database data source sets
+// for a database, keeping only those that apply to its engine
+func flattenDatabase(db *govultr.Database) map[string]interface{} {
+	f := map[string]interface{}{
+		"id":                      db.ID,
+		"date_created":            db.DateCreated,
+		"plan":                    db.Plan,
+		"plan_ram":                db.PlanRAM,
+		"plan_vcpus":              db.PlanVCPUs,
+		"region":                  db.Region,
+		"database_engine":         db.DatabaseEngine,
+		"database_engine_version": db.DatabaseEngineVersion,
+		"vpc_id":                  db.VPCID,
+		"status":                  db.Status,
+		"label":                   db.Label,
+		"tag":                     db.Tag,
+		"pending_charges":         db.PendingCharges,
+		"dbname":                  db.DBName,
+		"host":                    db.Host,
+		"public_host":             db.PublicHost,
+		"port":                    db.Port,
+		"user":                    db.User,
+		"password":                db.Password,
+		"maintenance_dow":         db.MaintenanceDOW,
+		"maintenance_time":        db.MaintenanceTime,
+		"latest_backup":           db.LatestBackup,
+		"trusted_ips":             db.TrustedIPs,
+		"ca_certificate":          db.CACertificate,
+		"read_replicas":           flattenReplicas(db),
+	}
+
+	if db.DatabaseEngine != "valkey" {
+		f["plan_disk"] = db.PlanDisk
+		f["cluster_time_zone"] = db.ClusterTimeZone
+	}
+
+	if db.DatabaseEngine == "kafka" {
+		f["plan_brokers"] = db.PlanBrokers
+		f["sasl_port"] = db.SASLPort
+		f["access_key"] = db.AccessKey
+		f["access_cert"] = db.AccessCert
+		f["kafka_rest_uri"] = db.KafkaRESTURI
+		f["schema_registry_uri"] = db.SchemaRegistryURI
+		if db.EnableKafkaREST != nil {
+			f["enable_kafka_rest"] = *db.EnableKafkaREST
+		}
+		if db.EnableSchemaRegistry != nil {
+			f["enable_schema_registry"] = *db.EnableSchemaRegistry
+		}
+		if db.EnableKafkaConnect != nil {
+			f["enable_kafka_connect"] = *db.EnableKafkaConnect
+		}
+	} else {
+		if db.PlanReplicas != nil {
+			f["plan_replicas"] = *db.PlanReplicas
+		}
+		if db.BackupHour != nil {
+			f["backup_hour"] = *db.BackupHour
+		}
+		if db.BackupMinute != nil {
+			f["backup_minute"] = *db.BackupMinute
+		}
+	}
+
+	if db.DatabaseEngine == "ferretpg" && db.FerretDBCredentials != nil {
+		f["ferretdb_credentials"] = flattenFerretDBCredentials(db)
+	}
+
+	if db.DatabaseEngine == "mysql" {
+		f["mysql_sql_modes"] = db.MySQLSQLModes
+		f["mysql_long_query_time"] = db.MySQLLongQueryTime
+		if db.MySQLRequirePrimaryKey != nil {
+			f["mysql_require_primary_key"] = *db.MySQLRequirePrimaryKey
+		}
+		if db.MySQLSlowQueryLog != nil {
+			f["mysql_slow_query_log"] = *db.MySQLSlowQueryLog
+		}
+	}
+
+	if db.DatabaseEngine == "valkey" {
+		f["eviction_policy"] = db.EvictionPolicy
+	}
+
+	return f
+}
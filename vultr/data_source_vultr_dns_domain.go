@@ -26,6 +26,32 @@ func dataSourceVultrDNSDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"soa": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsprimary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dnssec_ds_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dnssec_dnskey_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -48,5 +74,24 @@ func dataSourceVultrDNSDomainRead(ctx context.Context, d *schema.ResourceData, m
 	if err := d.Set("dns_sec", domain.DNSSec); err != nil {
 		return diag.Errorf("unable to set dns_domain `dns_sec` read value: %v", err)
 	}
+
+	soa, _, err := client.Domain.GetSoa(ctx, domain.Domain)
+	if err != nil {
+		return diag.Errorf("error getting dns domain SOA: %v", err)
+	}
+	if err := d.Set("soa", flattenDNSDomainSoa(soa)); err != nil {
+		return diag.Errorf("unable to set dns_domain `soa` read value: %v", err)
+	}
+
+	ds, dnskey, err := getDNSDomainSecRecords(ctx, client, domain)
+	if err != nil {
+		return diag.Errorf("error getting dns domain DNSSEC records: %v", err)
+	}
+	if err := d.Set("dnssec_ds_records", ds); err != nil {
+		return diag.Errorf("unable to set dns_domain `dnssec_ds_records` read value: %v", err)
+	}
+	if err := d.Set("dnssec_dnskey_records", dnskey); err != nil {
+		return diag.Errorf("unable to set dns_domain `dnssec_dnskey_records` read value: %v", err)
+	}
 	return nil
 }
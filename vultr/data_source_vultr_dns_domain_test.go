@@ -21,6 +21,8 @@ func TestAccVultrDnsDomainDataBase(t *testing.T) {
 					resource.TestCheckResourceAttrSet(name, "id"),
 					resource.TestCheckResourceAttr(name, "domain", domain),
 					resource.TestCheckResourceAttrSet(name, "date_created"),
+					resource.TestCheckResourceAttrSet(name, "soa.0.nsprimary"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_records.#", "0"),
 				),
 			},
 		},
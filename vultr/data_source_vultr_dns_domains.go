@@ -0,0 +1,72 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrDNSDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDNSDomainsRead,
+		Schema: map[string]*schema.Schema{
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"limit":       dataSourceLimitSchema(),
+			"dns_domains": dataSourceListSchema(dataSourceVultrDNSDomain()),
+		},
+	}
+}
+
+func dataSourceVultrDNSDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	domainList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		domains, meta, _, err := client.Domain.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting dns domains: %v", err)
+		}
+
+		for i := range domains {
+			ok, err := filterMatches(f, domains[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				domainList = append(domainList, map[string]interface{}{
+					"id":           domains[i].Domain,
+					"domain":       domains[i].Domain,
+					"date_created": domains[i].DateCreated,
+					"dns_sec":      domains[i].DNSSec,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	domainList, err = dataSourceListSelect(d, domainList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("dns_domains")
+	if err := d.Set("dns_domains", domainList); err != nil {
+		return diag.Errorf("error setting `dns_domains`: %#v", err)
+	}
+
+	return nil
+}
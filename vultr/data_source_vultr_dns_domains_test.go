@@ -0,0 +1,47 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrDNSDomains(t *testing.T) {
+	t.Parallel()
+	domain := fmt.Sprintf("%s.com", acctest.RandString(6))
+	name := "data.vultr_dns_domains.domains"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDNSDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrDNSDomainsConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "dns_domains.#", "1"),
+					resource.TestCheckResourceAttr(name, "dns_domains.0.id", domain),
+					resource.TestCheckResourceAttr(name, "dns_domains.0.domain", domain),
+					resource.TestCheckResourceAttrSet(name, "dns_domains.0.date_created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrDNSDomainsConfig(domain string) string {
+	return fmt.Sprintf(`
+		resource "vultr_dns_domain" "foo" {
+			domain = "%s"
+		}
+
+		data "vultr_dns_domains" "domains" {
+			filter {
+				name = "domain"
+				values = [vultr_dns_domain.foo.domain]
+			}
+		}
+		`, domain)
+}
@@ -0,0 +1,60 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceVultrDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDNSZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"keep_managed_records": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVultrDNSZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+	domain := d.Get("domain").(string)
+
+	list, err := listDNSZoneRecords(ctx, client, domain, d.Get("keep_managed_records").(bool))
+	if err != nil {
+		return diag.Errorf("error getting dns records for %s: %v", domain, err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(list))
+	for i := range list {
+		records = append(records, flattenDNSZoneRecord(&list[i]))
+	}
+
+	d.SetId(domain)
+	if err := d.Set("zone_file", renderZoneFile(domain, records)); err != nil {
+		return diag.Errorf("unable to set dns_zone_file `zone_file` read value: %v", err)
+	}
+	if err := d.Set("record_count", len(records)); err != nil {
+		return diag.Errorf("unable to set dns_zone_file `record_count` read value: %v", err)
+	}
+
+	return nil
+}
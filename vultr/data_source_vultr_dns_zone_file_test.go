@@ -0,0 +1,40 @@
+package vultr
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrDNSZoneFileDataBase(t *testing.T) {
+	domain := fmt.Sprintf("%s.com", acctest.RandString(6))
+	name := "data.vultr_dns_zone_file.my-site"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDNSZoneFileDataConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", domain),
+					resource.TestMatchResourceAttr(name, "zone_file", regexp.MustCompile(`@\t\d+\tIN\tA\t10\.0\.0\.0`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccVultrDNSZoneFileDataConfig(domain string) string {
+	return fmt.Sprintf(`
+			data "vultr_dns_zone_file" "my-site" {
+				domain = vultr_dns_domain.my-site.id
+			}
+
+			resource "vultr_dns_domain" "my-site" {
+				domain = "%s"
+				ip = "10.0.0.0"
+			}`, domain)
+}
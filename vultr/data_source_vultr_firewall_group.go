@@ -12,7 +12,10 @@ func dataSourceVultrFirewallGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrFirewallGroupRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,7 +54,11 @@ func dataSourceVultrFirewallGroupRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	firewallGroupList := []govultr.FirewallGroup{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -80,6 +87,11 @@ func dataSourceVultrFirewallGroupRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	firewallGroupList, err = dataSourceSelect(d, firewallGroupList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(firewallGroupList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
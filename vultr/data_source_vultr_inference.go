@@ -12,7 +12,10 @@ func dataSourceVultrInference() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrInferenceRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,7 +42,11 @@ func dataSourceVultrInferenceRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	var inferenceList []govultr.Inference
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	inferenceSubs, _, err := client.Inference.List(ctx)
 	if err != nil {
 		return diag.Errorf("error getting inference subscriptions: %v", err)
@@ -58,6 +65,11 @@ func dataSourceVultrInferenceRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	inferenceList, err = dataSourceSelect(d, inferenceList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(inferenceList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -13,7 +13,10 @@ func dataSourceVultrInstance() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrInstanceRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"os": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -173,7 +176,11 @@ func dataSourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var serverList []govultr.Instance
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{PerPage: 400}
 	for {
 		servers, meta, _, err := client.Instance.List(ctx, options)
@@ -202,6 +209,11 @@ func dataSourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	serverList, err = dataSourceSelect(d, serverList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(serverList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -88,7 +88,11 @@ func dataSourceVultrInstanceIPV4Read(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	filter := buildVultrDataSourceFilter(filters.(*schema.Set))
+	filter, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var result *govultr.IPv4
 	resultInstanceID := ""
 
@@ -181,7 +181,11 @@ func dataSourceVultrInstancesRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	serverList := make([]interface{}, 0)
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 	for {
 		servers, meta, _, err := client.Instance.List(ctx, options)
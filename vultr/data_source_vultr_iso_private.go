@@ -12,7 +12,10 @@ func dataSourceVultrIsoPrivate() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrIsoPrivateRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -50,7 +53,11 @@ func dataSourceVultrIsoPrivateRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var isoList []govultr.ISO
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -78,6 +85,11 @@ func dataSourceVultrIsoPrivateRead(ctx context.Context, d *schema.ResourceData,
 			continue
 		}
 	}
+	isoList, err = dataSourceSelect(d, isoList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(isoList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -13,6 +13,8 @@ func dataSourceVultrIsoPublic() *schema.Resource {
 		ReadContext: dataSourceVultrIsoPublicRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,7 +41,11 @@ func dataSourceVultrIsoPublicRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	isoList := []govultr.PublicISO{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -68,6 +74,11 @@ func dataSourceVultrIsoPublicRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	isoList, err = dataSourceSelect(d, isoList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(isoList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -2,6 +2,7 @@ package vultr
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,7 +13,10 @@ func dataSourceVultrKubernetes() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrKubernetesRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -230,7 +234,11 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var k8List []govultr.Cluster
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 	for {
 		k8s, meta, _, err := client.Kubernetes.ListClusters(ctx, options)
@@ -258,6 +266,11 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	k8List, err = dataSourceSelect(d, k8List)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(k8List) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -335,14 +348,29 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("unable to set kubernetes `client_key` read value: %v", err)
 	}
 
-	nodePools := flattenNodePools(k8List[0].NodePools)
+	nodePools, err := flattenNodePoolsWithLabels(ctx, client, k8List[0].ID, k8List[0].NodePools)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("node_pools", nodePools); err != nil {
+		return diag.Errorf("unable to set kubernetes `node_pools` read value: %v", err)
+	}
+
+	return nil
+}
+
+// flattenNodePoolsWithLabels flattens a cluster's node pools along with the
+// labels and taints of each pool, which are only returned by their own endpoints
+func flattenNodePoolsWithLabels(ctx context.Context, client *govultr.Client, clusterID string, np []govultr.NodePool) ([]map[string]interface{}, error) { //nolint:lll
+	nodePools := flattenNodePools(np)
 
 	for i := range nodePools {
-		labelData, _, err := client.Kubernetes.ListNodePoolLabels(ctx, k8List[0].ID, nodePools[i]["id"].(string))
+		labelData, _, err := client.Kubernetes.ListNodePoolLabels(ctx, clusterID, nodePools[i]["id"].(string))
 		if err != nil {
-			return diag.Errorf(
+			return nil, fmt.Errorf(
 				"error getting data source cluster (%v) node pool (%v) labels : %v",
-				k8List[0].ID,
+				clusterID,
 				nodePools[i]["id"].(string),
 				err,
 			)
@@ -359,11 +387,11 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 
 		nodePools[i]["labels"] = labels
 
-		taintData, _, err := client.Kubernetes.ListNodePoolTaints(ctx, k8List[0].ID, nodePools[i]["id"].(string))
+		taintData, _, err := client.Kubernetes.ListNodePoolTaints(ctx, clusterID, nodePools[i]["id"].(string))
 		if err != nil {
-			return diag.Errorf(
+			return nil, fmt.Errorf(
 				"error getting data source cluster (%v) node pool (%v) taints : %v",
-				k8List[0].ID,
+				clusterID,
 				nodePools[i]["id"].(string),
 				err,
 			)
@@ -382,11 +410,7 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 		nodePools[i]["taints"] = taints
 	}
 
-	if err := d.Set("node_pools", nodePools); err != nil {
-		return diag.Errorf("unable to set kubernetes `node_pools` read value: %v", err)
-	}
-
-	return nil
+	return nodePools, nil
 }
 
 func flattenNodePools(np []govultr.NodePool) []map[string]interface{} {
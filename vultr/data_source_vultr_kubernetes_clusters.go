@@ -0,0 +1,95 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrKubernetesClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrKubernetesClustersRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"limit":  dataSourceLimitSchema(),
+			// the cluster credentials need a kubeconfig request per cluster
+			// and are left to the vultr_kubernetes data source
+			"kubernetes_clusters": dataSourceListSchema(dataSourceVultrKubernetes(),
+				"kube_config", "cluster_ca_certificate", "client_key", "client_certificate"),
+		},
+	}
+}
+
+func dataSourceVultrKubernetesClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	k8List := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		k8s, meta, _, err := client.Kubernetes.ListClusters(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting kubernetes clusters: %v", err)
+		}
+
+		for i := range k8s {
+			ok, err := filterMatches(f, k8s[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			nodePools, err := flattenNodePoolsWithLabels(ctx, client, k8s[i].ID, k8s[i].NodePools)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			k8List = append(k8List, map[string]interface{}{
+				"id":                  k8s[i].ID,
+				"label":               k8s[i].Label,
+				"date_created":        k8s[i].DateCreated,
+				"cluster_subnet":      k8s[i].ClusterSubnet,
+				"service_subnet":      k8s[i].ServiceSubnet,
+				"ip":                  k8s[i].IP,
+				"endpoint":            k8s[i].Endpoint,
+				"version":             k8s[i].Version,
+				"ha_controlplanes":    k8s[i].HAControlPlanes,
+				"firewall_group_id":   k8s[i].FirewallGroupID,
+				"region":              k8s[i].Region,
+				"status":              k8s[i].Status,
+				"node_pools":          nodePools,
+				"oidc_issuer_url":     k8s[i].OIDCConfig.IssuerURL,
+				"oidc_client_id":      k8s[i].OIDCConfig.ClientID,
+				"oidc_username_claim": k8s[i].OIDCConfig.UserNameClaim,
+				"oidc_groups_claim":   k8s[i].OIDCConfig.GroupsClaim,
+			})
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	k8List, err = dataSourceListSelect(d, k8List)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("kubernetes_clusters")
+	if err := d.Set("kubernetes_clusters", k8List); err != nil {
+		return diag.Errorf("error setting `kubernetes_clusters`: %#v", err)
+	}
+
+	return nil
+}
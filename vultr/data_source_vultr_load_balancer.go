@@ -13,7 +13,10 @@ func dataSourceVultrLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrLoadBalancerRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,7 +115,11 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("issue with filter: %v", filtersOk)
 	}
 	var lbList []govultr.LoadBalancer
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 	for {
 		lbs, meta, _, err := client.LoadBalancer.List(ctx, options)
@@ -139,6 +146,11 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 			continue
 		}
 	}
+	lbList, err = dataSourceSelect(d, lbList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(lbList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -0,0 +1,147 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// dataSourceVultrLoadBalancerBackends reports the state of a load balancer and
+// of each attached instance. The API returns neither the results nor the time
+// of the load balancer health checks, so per-backend health is not offered;
+// a backend is only reported as ready when its instance is active, running
+// and reporting an ok server status.
+func dataSourceVultrLoadBalancerBackends() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrLoadBalancerBackendsRead,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_ssl": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auto_ssl_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ready_backends": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"backends": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_ready": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVultrLoadBalancerBackendsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	lb, _, err := client.LoadBalancer.Get(ctx, lbID)
+	if err != nil {
+		return diag.Errorf("error getting load balancer %s : %v", lbID, err)
+	}
+
+	backends, ready, err := loadBalancerBackends(ctx, client, lb)
+	if err != nil {
+		return diag.Errorf("error getting backends of load balancer %s : %v", lbID, err)
+	}
+
+	autoSSLDomain := ""
+	if lb.AutoSSL != nil {
+		autoSSLDomain = lb.AutoSSL.Domain
+	}
+
+	d.SetId(lbID)
+	if err := d.Set("status", lb.Status); err != nil {
+		return diag.Errorf("unable to set load_balancer_backends `status` read value: %v", err)
+	}
+	if err := d.Set("has_ssl", lb.SSLInfo != nil && *lb.SSLInfo); err != nil {
+		return diag.Errorf("unable to set load_balancer_backends `has_ssl` read value: %v", err)
+	}
+	if err := d.Set("auto_ssl_domain", autoSSLDomain); err != nil {
+		return diag.Errorf("unable to set load_balancer_backends `auto_ssl_domain` read value: %v", err)
+	}
+	if err := d.Set("ready_backends", ready); err != nil {
+		return diag.Errorf("unable to set load_balancer_backends `ready_backends` read value: %v", err)
+	}
+	if err := d.Set("backends", backends); err != nil {
+		return diag.Errorf("unable to set load_balancer_backends `backends` read value: %v", err)
+	}
+
+	return nil
+}
+
+// loadBalancerBackends returns the state of each instance attached to the
+// load balancer and how many of them are ready. Instances that no longer
+// exist are reported as missing.
+func loadBalancerBackends(ctx context.Context, client *govultr.Client, lb *govultr.LoadBalancer) ([]map[string]interface{}, int, error) { //nolint:lll
+	backends := make([]map[string]interface{}, 0, len(lb.Instances))
+	ready := 0
+
+	for _, id := range lb.Instances {
+		backend := map[string]interface{}{"instance_id": id, "status": "missing", "instance_ready": false}
+
+		instance, _, err := client.Instance.Get(ctx, id)
+		if err != nil && !isNotFoundError(err) {
+			return nil, 0, err
+		}
+		if err == nil {
+			ok := instance.Status == "active" && instance.PowerStatus == "running" && instance.ServerStatus == "ok"
+			backend["label"] = instance.Label
+			backend["status"] = instance.Status
+			backend["power_status"] = instance.PowerStatus
+			backend["server_status"] = instance.ServerStatus
+			backend["instance_ready"] = ok
+			if ok {
+				ready++
+			}
+		}
+
+		backends = append(backends, backend)
+	}
+
+	return backends, ready, nil
+}
@@ -0,0 +1,59 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrLoadBalancerBackends(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-lb-backends")
+	name := "data.vultr_load_balancer_backends.lb"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrLoadBalancerBackendsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "status", "active"),
+					resource.TestCheckResourceAttr(name, "ready_backends", "1"),
+					resource.TestCheckResourceAttr(name, "backends.#", "1"),
+					resource.TestCheckResourceAttr(name, "backends.0.instance_ready", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrLoadBalancerBackendsConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_instance" "web" {
+			plan   = "vc2-1c-1gb"
+			region = "ewr"
+			os_id  = 1743
+			label  = "%[1]s"
+		}
+
+		resource "vultr_load_balancer" "lb" {
+			region                  = "ewr"
+			label                   = "%[1]s"
+			attached_instances      = [vultr_instance.web.id]
+			wait_for_ready_backends = 1
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		data "vultr_load_balancer_backends" "lb" {
+			load_balancer_id = vultr_load_balancer.lb.id
+		}`, name)
+}
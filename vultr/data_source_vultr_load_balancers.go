@@ -0,0 +1,148 @@
+package vultr
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrLoadBalancers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrLoadBalancersRead,
+		Schema: map[string]*schema.Schema{
+			"filter":         dataSourceFiltersSchema(),
+			"sort":           dataSourceSortSchema(),
+			"limit":          dataSourceLimitSchema(),
+			"load_balancers": dataSourceListSchema(dataSourceVultrLoadBalancer()),
+		},
+	}
+}
+
+func dataSourceVultrLoadBalancersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	lbList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		lbs, meta, _, err := client.LoadBalancer.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting load balancers: %v", err)
+		}
+
+		for i := range lbs {
+			ok, err := filterMatches(f, lbs[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				lbList = append(lbList, flattenLoadBalancer(&lbs[i]))
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	lbList, err = dataSourceListSelect(d, lbList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("load_balancers")
+	if err := d.Set("load_balancers", lbList); err != nil {
+		return diag.Errorf("error setting `load_balancers`: %#v", err)
+	}
+
+	return nil
+}
+
+func flattenLoadBalancer(lb *govultr.LoadBalancer) map[string]interface{} {
+	var rulesList []map[string]interface{}
+	for _, rules := range lb.ForwardingRules {
+		rulesList = append(rulesList, map[string]interface{}{
+			"rule_id":           rules.RuleID,
+			"frontend_protocol": rules.FrontendProtocol,
+			"frontend_port":     strconv.Itoa(rules.FrontendPort),
+			"backend_protocol":  rules.BackendProtocol,
+			"backend_port":      strconv.Itoa(rules.BackendPort),
+		})
+	}
+
+	var fwrRules []map[string]interface{}
+	for _, rules := range lb.FirewallRules {
+		fwrRules = append(fwrRules, map[string]interface{}{
+			"id":      rules.RuleID,
+			"ip_type": rules.IPType,
+			"port":    strconv.Itoa(rules.Port),
+			"source":  rules.Source,
+		})
+	}
+
+	l := map[string]interface{}{
+		"id":                 lb.ID,
+		"date_created":       lb.DateCreated,
+		"status":             lb.Status,
+		"region":             lb.Region,
+		"label":              lb.Label,
+		"nodes":              lb.Nodes,
+		"attached_instances": lb.Instances,
+		"ipv4":               lb.IPV4,
+		"ipv6":               lb.IPV6,
+		"global_regions":     lb.GlobalRegions,
+		"forwarding_rules":   rulesList,
+		"firewall_rules":     fwrRules,
+	}
+
+	if lb.SSLInfo != nil {
+		l["has_ssl"] = *lb.SSLInfo
+	}
+
+	if lb.GenericInfo != nil {
+		l["balancing_algorithm"] = lb.GenericInfo.BalancingAlgorithm
+		if lb.GenericInfo.SSLRedirect != nil {
+			l["ssl_redirect"] = *lb.GenericInfo.SSLRedirect
+		}
+		if lb.GenericInfo.ProxyProtocol != nil {
+			l["proxy_protocol"] = *lb.GenericInfo.ProxyProtocol
+		}
+		if lb.GenericInfo.StickySessions != nil {
+			l["cookie_name"] = lb.GenericInfo.StickySessions.CookieName
+		}
+	}
+
+	if lb.HealthCheck != nil {
+		l["health_check"] = map[string]interface{}{
+			"protocol":            lb.HealthCheck.Protocol,
+			"port":                strconv.Itoa(lb.HealthCheck.Port),
+			"path":                lb.HealthCheck.Path,
+			"check_interval":      strconv.Itoa(lb.HealthCheck.CheckInterval),
+			"response_timeout":    strconv.Itoa(lb.HealthCheck.ResponseTimeout),
+			"unhealthy_threshold": strconv.Itoa(lb.HealthCheck.UnhealthyThreshold),
+			"healthy_threshold":   strconv.Itoa(lb.HealthCheck.HealthyThreshold),
+		}
+	}
+
+	if lb.AutoSSL != nil {
+		l["auto_ssl_domain"] = lb.AutoSSL.Domain
+	}
+
+	if lb.HTTP2 != nil && *lb.HTTP2 {
+		l["http_version"] = 2
+		if lb.HTTP3 != nil && *lb.HTTP3 {
+			l["http_version"] = 3
+		}
+	}
+
+	return l
+}
@@ -0,0 +1,58 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrLoadBalancers(t *testing.T) {
+	t.Parallel()
+	rLabel := acctest.RandomWithPrefix("tf-lb-ds")
+	name := "data.vultr_load_balancers.lbs"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrLoadBalancersConfig(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "load_balancers.#", "1"),
+					resource.TestCheckResourceAttr(name, "load_balancers.0.label", rLabel),
+					resource.TestCheckResourceAttr(name, "load_balancers.0.balancing_algorithm", "roundrobin"),
+					resource.TestCheckResourceAttr(name, "load_balancers.0.forwarding_rules.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "load_balancers.0.health_check.protocol"),
+					resource.TestCheckResourceAttrSet(name, "load_balancers.0.ipv4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrLoadBalancersConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "foo" {
+			region = "ewr"
+			label = "%s"
+			balancing_algorithm = "roundrobin"
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port = 80
+				backend_protocol = "http"
+				backend_port = 80
+			}
+		}
+
+		data "vultr_load_balancers" "lbs" {
+			filter {
+				name = "label"
+				values = [vultr_load_balancer.foo.label]
+			}
+		}
+		`, label)
+}
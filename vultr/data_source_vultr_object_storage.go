@@ -12,7 +12,10 @@ func dataSourceVultrObjectStorage() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrObjectStorageRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -64,7 +67,11 @@ func dataSourceVultrObjectStorageRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	objStoreList := []govultr.ObjectStorage{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -93,6 +100,11 @@ func dataSourceVultrObjectStorageRead(ctx context.Context, d *schema.ResourceDat
 			continue
 		}
 	}
+	objStoreList, err = dataSourceSelect(d, objStoreList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(objStoreList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -14,6 +14,8 @@ func dataSourceVultrObjectStorageClusters() *schema.Resource {
 		ReadContext: dataSourceVultrObjectStorageClustersRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -43,7 +45,11 @@ func dataSourceVultrObjectStorageClustersRead(ctx context.Context, d *schema.Res
 	}
 
 	clusterList := []govultr.ObjectStorageCluster{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -73,6 +79,11 @@ func dataSourceVultrObjectStorageClustersRead(ctx context.Context, d *schema.Res
 		}
 	}
 
+	clusterList, err = dataSourceSelect(d, clusterList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(clusterList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -14,6 +14,8 @@ func dataSourceVultrObjectStorageTier() *schema.Resource {
 		ReadContext: dataSourceVultrObjectStorageTierRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -71,7 +73,10 @@ func dataSourceVultrObjectStorageTierRead(ctx context.Context, d *schema.Resourc
 	}
 
 	tierList := []govultr.ObjectStorageTier{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tiers, _, err := client.ObjectStorage.ListTiers(ctx)
 	if err != nil {
@@ -90,6 +95,11 @@ func dataSourceVultrObjectStorageTierRead(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	tierList, err = dataSourceSelect(d, tierList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(tierList) > 1 {
 		return diag.Errorf(`your object storage tier search returned too many results. 
 Please refine your search to be more specific`)
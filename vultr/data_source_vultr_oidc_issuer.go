@@ -13,6 +13,8 @@ func dataSourceVultrOIDCIssuer() *schema.Resource {
 		ReadContext: dataSourceVultrOIDCIssuerRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"source": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,7 +61,11 @@ func dataSourceVultrOIDCIssuerRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var issuerList []govultr.OIDCIssuer
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	issuers, _, err := client.OIDC.ListOIDCIssuers(ctx)
 	if err != nil {
 		return diag.Errorf("error getting oidc issuers: %v", err)
@@ -77,6 +83,11 @@ func dataSourceVultrOIDCIssuerRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	issuerList, err = dataSourceSelect(d, issuerList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(issuerList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -13,6 +13,8 @@ func dataSourceVultrOIDCProvider() *schema.Resource {
 		ReadContext: dataSourceVultrOIDCProviderRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -35,7 +37,11 @@ func dataSourceVultrOIDCProviderRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	var provList []govultr.OIDCProvider
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	provs, _, err := client.OIDC.ListOIDCProviders(ctx)
 	if err != nil {
 		return diag.Errorf("error getting oidc providers: %v", err)
@@ -53,6 +59,11 @@ func dataSourceVultrOIDCProviderRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	provList, err = dataSourceSelect(d, provList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(provList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
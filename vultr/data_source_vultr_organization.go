@@ -12,7 +12,10 @@ func dataSourceVultrOrganization() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrOrganizationRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,7 +42,11 @@ func dataSourceVultrOrganizationRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	var orgList []govultr.Organization
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 	for {
 		list, meta, _, err := client.Organization.ListOrganizations(ctx, options)
@@ -67,6 +74,11 @@ func dataSourceVultrOrganizationRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	orgList, err = dataSourceSelect(d, orgList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(orgList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -12,7 +12,10 @@ func dataSourceVultrOrganizationGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrOrganizationGroupRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -54,7 +57,11 @@ func dataSourceVultrOrganizationGroupRead(ctx context.Context, d *schema.Resourc
 	}
 
 	var groupList []govultr.OrganizationGroup
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{PerPage: 10}
 	for {
 		list, meta, _, err := client.Organization.ListGroups(ctx, options)
@@ -82,6 +89,11 @@ func dataSourceVultrOrganizationGroupRead(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	groupList, err = dataSourceSelect(d, groupList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(groupList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
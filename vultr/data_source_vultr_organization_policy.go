@@ -12,7 +12,10 @@ func dataSourceVultrOrganizationPolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrOrganizationPolicyRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -91,7 +94,11 @@ func dataSourceVultrOrganizationPolicyRead(ctx context.Context, d *schema.Resour
 	}
 
 	var policyList []govultr.OrganizationPolicy
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 	for {
 		list, meta, _, err := client.Organization.ListPolicies(ctx, options)
@@ -119,6 +126,11 @@ func dataSourceVultrOrganizationPolicyRead(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	policyList, err = dataSourceSelect(d, policyList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(policyList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -12,7 +12,10 @@ func dataSourceVultrOrganizationRole() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrOrganizationRoleRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,7 +60,11 @@ func dataSourceVultrOrganizationRoleRead(ctx context.Context, d *schema.Resource
 	}
 
 	var roleList []govultr.OrganizationRole
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{PerPage: 10}
 	for {
 		list, meta, _, err := client.Organization.ListRoles(ctx, options)
@@ -85,6 +92,11 @@ func dataSourceVultrOrganizationRoleRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	roleList, err = dataSourceSelect(d, roleList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(roleList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -14,6 +14,8 @@ func dataSourceVultrOS() *schema.Resource {
 		ReadContext: dataSourceVultrOSRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -31,7 +33,7 @@ func dataSourceVultrOS() *schema.Resource {
 }
 
 func dataSourceVultrOSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client).govultrClient()
+	client := meta.(*Client)
 
 	filters, filtersOk := d.GetOk("filter")
 	if !filtersOk {
@@ -39,34 +41,33 @@ func dataSourceVultrOSRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	osList := []govultr.OS{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		os, meta, _, err := client.OS.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting os list: %v", err)
-		}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, o := range os {
-			sm, err := structToMap(o)
+	os, err := client.operatingSystems(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, o := range os {
+		sm, err := structToMap(o)
 
-			if filterLoop(f, sm) {
-				osList = append(osList, o)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			osList = append(osList, o)
 		}
 	}
 
+	osList, err = dataSourceSelect(d, osList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(osList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -13,6 +13,8 @@ func dataSourceVultrPlan() *schema.Resource {
 		ReadContext: dataSourceVultrPlanRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"vcpu_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -59,7 +61,7 @@ func dataSourceVultrPlan() *schema.Resource {
 }
 
 func dataSourceVultrPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client).govultrClient()
+	client := meta.(*Client)
 
 	filters, filtersOk := d.GetOk("filter")
 	if !filtersOk {
@@ -67,36 +69,34 @@ func dataSourceVultrPlanRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	planList := []govultr.Plan{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-
-	for {
-		plans, meta, _, err := client.Plan.List(ctx, "", options)
-		if err != nil {
-			return diag.Errorf("Error getting plans: %v", err)
-		}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, a := range plans {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
+	plans, err := client.plans(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, a := range plans {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
-			if filterLoop(f, sm) {
-				planList = append(planList, a)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			planList = append(planList, a)
 		}
 	}
 
+	planList, err = dataSourceSelect(d, planList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(planList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
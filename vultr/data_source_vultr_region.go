@@ -13,6 +13,8 @@ func dataSourceVultrRegion() *schema.Resource {
 		ReadContext: dataSourceVultrRegionRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"country": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -40,7 +42,7 @@ func dataSourceVultrRegion() *schema.Resource {
 }
 
 func dataSourceVultrRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client).govultrClient()
+	client := meta.(*Client)
 
 	filters, filtersOk := d.GetOk("filter")
 
@@ -49,35 +51,34 @@ func dataSourceVultrRegionRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	regionList := []govultr.Region{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		regions, meta, _, err := client.Region.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("Error getting regions: %v", err)
-		}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, a := range regions {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
+	regions, err := client.regions(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, a := range regions {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
-			if filterLoop(f, sm) {
-				regionList = append(regionList, a)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			regionList = append(regionList, a)
 		}
 	}
 
+	regionList, err = dataSourceSelect(d, regionList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(regionList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
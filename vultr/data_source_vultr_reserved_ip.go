@@ -13,6 +13,8 @@ func dataSourceVultrReservedIP() *schema.Resource {
 		ReadContext: dataSourceVultrReservedIPRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -50,7 +52,11 @@ func dataSourceVultrReservedIPRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	ipList := []govultr.ReservedIP{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -78,6 +84,11 @@ func dataSourceVultrReservedIPRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	ipList, err = dataSourceSelect(d, ipList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(ipList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -0,0 +1,75 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrReservedIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrReservedIPsRead,
+		Schema: map[string]*schema.Schema{
+			"filter":       dataSourceFiltersSchema(),
+			"sort":         dataSourceSortSchema(),
+			"limit":        dataSourceLimitSchema(),
+			"reserved_ips": dataSourceListSchema(dataSourceVultrReservedIP()),
+		},
+	}
+}
+
+func dataSourceVultrReservedIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	ipList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		ips, meta, _, err := client.ReservedIP.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting list of reserved ips: %v", err)
+		}
+
+		for i := range ips {
+			ok, err := filterMatches(f, ips[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				ipList = append(ipList, map[string]interface{}{
+					"id":          ips[i].ID,
+					"region":      ips[i].Region,
+					"ip_type":     ips[i].IPType,
+					"subnet":      ips[i].Subnet,
+					"subnet_size": ips[i].SubnetSize,
+					"label":       ips[i].Label,
+					"instance_id": ips[i].InstanceID,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	ipList, err = dataSourceListSelect(d, ipList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("reserved_ips")
+	if err := d.Set("reserved_ips", ipList); err != nil {
+		return diag.Errorf("error setting `reserved_ips`: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrReservedIPs(t *testing.T) {
+	t.Parallel()
+	rLabel := acctest.RandomWithPrefix("tf-rip-ds")
+	name := "data.vultr_reserved_ips.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrReservedIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrReservedIPsConfig(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "reserved_ips.#", "2"),
+					resource.TestCheckResourceAttr(name, "reserved_ips.0.label", rLabel),
+					resource.TestCheckResourceAttrSet(name, "reserved_ips.0.subnet"),
+					resource.TestCheckResourceAttrSet(name, "reserved_ips.1.ip_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrReservedIPsConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_reserved_ip" "bar" {
+			label = "%s"
+			region = "sea"
+			ip_type = each.key
+			for_each = toset(["v4", "v6"])
+		}
+
+		data "vultr_reserved_ips" "foo" {
+			filter {
+				name = "label"
+				values = ["%s"]
+			}
+
+			depends_on = [vultr_reserved_ip.bar]
+		}
+		`, label, label)
+}
@@ -83,7 +83,11 @@ func dataSourceVultrReverseIPV6Read(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	filter := buildVultrDataSourceFilter(filters.(*schema.Set))
+	filter, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var result *govultr.ReverseIP
 	resultInstanceID := ""
 
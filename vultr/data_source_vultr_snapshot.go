@@ -12,7 +12,10 @@ func dataSourceVultrSnapshot() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrSnapshotRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,7 +54,11 @@ func dataSourceVultrSnapshotRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var snapshotList []govultr.Snapshot
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -79,6 +86,11 @@ func dataSourceVultrSnapshotRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	snapshotList, err = dataSourceSelect(d, snapshotList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(snapshotList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -0,0 +1,75 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"filter":    dataSourceFiltersSchema(),
+			"sort":      dataSourceSortSchema(),
+			"limit":     dataSourceLimitSchema(),
+			"snapshots": dataSourceListSchema(dataSourceVultrSnapshot()),
+		},
+	}
+}
+
+func dataSourceVultrSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	snapshotList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		snapshots, meta, _, err := client.Snapshot.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting snapshots: %v", err)
+		}
+
+		for i := range snapshots {
+			ok, err := filterMatches(f, snapshots[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				snapshotList = append(snapshotList, map[string]interface{}{
+					"id":           snapshots[i].ID,
+					"date_created": snapshots[i].DateCreated,
+					"description":  snapshots[i].Description,
+					"size":         snapshots[i].Size,
+					"status":       snapshots[i].Status,
+					"os_id":        snapshots[i].OsID,
+					"app_id":       snapshots[i].AppID,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	snapshotList, err = dataSourceListSelect(d, snapshotList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("snapshots")
+	if err := d.Set("snapshots", snapshotList); err != nil {
+		return diag.Errorf("error setting `snapshots`: %#v", err)
+	}
+
+	return nil
+}
@@ -12,7 +12,10 @@ func dataSourceVultrSSHKey() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrSSHKeyRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,7 +46,11 @@ func dataSourceVultrSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	sshKeyList := []govultr.SSHKey{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -71,6 +78,11 @@ func dataSourceVultrSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta
 			continue
 		}
 	}
+	sshKeyList, err = dataSourceSelect(d, sshKeyList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(sshKeyList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
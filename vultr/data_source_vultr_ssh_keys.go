@@ -0,0 +1,72 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrSSHKeysRead,
+		Schema: map[string]*schema.Schema{
+			"filter":   dataSourceFiltersSchema(),
+			"sort":     dataSourceSortSchema(),
+			"limit":    dataSourceLimitSchema(),
+			"ssh_keys": dataSourceListSchema(dataSourceVultrSSHKey()),
+		},
+	}
+}
+
+func dataSourceVultrSSHKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	sshKeyList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		sshKeys, meta, _, err := client.SSHKey.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting ssh keys: %v", err)
+		}
+
+		for i := range sshKeys {
+			ok, err := filterMatches(f, sshKeys[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				sshKeyList = append(sshKeyList, map[string]interface{}{
+					"id":           sshKeys[i].ID,
+					"name":         sshKeys[i].Name,
+					"ssh_key":      sshKeys[i].SSHKey,
+					"date_created": sshKeys[i].DateCreated,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	sshKeyList, err = dataSourceListSelect(d, sshKeyList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("ssh_keys")
+	if err := d.Set("ssh_keys", sshKeyList); err != nil {
+		return diag.Errorf("error setting `ssh_keys`: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrSSHKeys(t *testing.T) {
+	rName := fmt.Sprintf("%s-%d-terraform", acctest.RandString(3), acctest.RandInt())
+	rSSH, _, err := acctest.RandSSHKeyPair("foobar")
+	name := "data.vultr_ssh_keys.keys"
+	if err != nil {
+		t.Fatalf("Error generating test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrSSHKeysConfig(rName, rSSH),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttr(name, "ssh_keys.0.name", rName),
+					resource.TestCheckResourceAttrSet(name, "ssh_keys.0.ssh_key"),
+					resource.TestCheckResourceAttrSet(name, "ssh_keys.0.date_created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrSSHKeysConfig(name, ssh string) string {
+	return fmt.Sprintf(`
+		resource "vultr_ssh_key" "foo" {
+			name = "%s"
+			ssh_key = "%s"
+		}
+
+		data "vultr_ssh_keys" "keys" {
+			filter {
+				name = "name"
+				values = [vultr_ssh_key.foo.name]
+			}
+		}
+		`, name, ssh)
+}
@@ -12,7 +12,10 @@ func dataSourceVultrStartupScript() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrStartupScriptRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +50,11 @@ func dataSourceVultrStartupScriptRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	var scriptList []govultr.StartupScript
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -75,6 +82,11 @@ func dataSourceVultrStartupScriptRead(ctx context.Context, d *schema.ResourceDat
 			continue
 		}
 	}
+	scriptList, err = dataSourceSelect(d, scriptList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(scriptList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -13,6 +13,8 @@ func dataSourceVultrUser() *schema.Resource {
 		ReadContext: dataSourceVultrUserRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"first":  dataSourceFirstSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,7 +61,11 @@ func dataSourceVultrUserRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	options := &govultr.ListOptions{}
 	userList := []govultr.User{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	for {
 		users, meta, _, err := client.User.List(ctx, options)
 		if err != nil {
@@ -86,6 +92,11 @@ func dataSourceVultrUserRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	userList, err = dataSourceSelect(d, userList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(userList) > 1 {
 		return diag.Errorf(
 			"your search returned too many results : %d. Please refine your search to be more specific",
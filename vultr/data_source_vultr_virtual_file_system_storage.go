@@ -12,7 +12,10 @@ func dataSourceVultrVirtualFileSystemStorage() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrVirtualFileSystemStorageRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -89,7 +92,10 @@ func dataSourceVultrVirtualFileSystemStorageRead(ctx context.Context, d *schema.
 	}
 
 	var storageList []govultr.VirtualFileSystemStorage
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	options := &govultr.ListOptions{}
 	for {
@@ -117,6 +123,11 @@ func dataSourceVultrVirtualFileSystemStorageRead(ctx context.Context, d *schema.
 		}
 	}
 
+	storageList, err = dataSourceSelect(d, storageList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(storageList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
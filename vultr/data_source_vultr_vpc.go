@@ -12,7 +12,10 @@ func dataSourceVultrVPC() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrVPCRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +50,11 @@ func dataSourceVultrVPCRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	var vpcList []govultr.VPC
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -77,6 +84,11 @@ func dataSourceVultrVPCRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	vpcList, err = dataSourceSelect(d, vpcList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(vpcList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -12,7 +12,10 @@ func dataSourceVultrVPC2() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrVPC2Read,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"first":       dataSourceFirstSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -48,7 +51,11 @@ func dataSourceVultrVPC2Read(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	var vpcList []govultr.VPC2 //nolint:staticcheck
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	options := &govultr.ListOptions{}
 
 	for {
@@ -78,6 +85,11 @@ func dataSourceVultrVPC2Read(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	vpcList, err = dataSourceSelect(d, vpcList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(vpcList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -0,0 +1,75 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrVPC2s() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrVPC2sRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"limit":  dataSourceLimitSchema(),
+			"vpc2s":  dataSourceListSchema(dataSourceVultrVPC2()),
+		},
+		DeprecationMessage: "VPC2 is deprecated and will not be supported in a future release.  Use VPC instead",
+	}
+}
+
+func dataSourceVultrVPC2sRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	vpcList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		vpcs, meta, _, err := client.VPC2.List(ctx, options) //nolint:staticcheck
+		if err != nil {
+			return diag.Errorf("error getting VPCs 2.0: %v", err)
+		}
+
+		for i := range vpcs {
+			ok, err := filterMatches(f, vpcs[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				vpcList = append(vpcList, map[string]interface{}{
+					"id":            vpcs[i].ID,
+					"region":        vpcs[i].Region,
+					"description":   vpcs[i].Description,
+					"date_created":  vpcs[i].DateCreated,
+					"ip_block":      vpcs[i].IPBlock,
+					"prefix_length": vpcs[i].PrefixLength,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	vpcList, err = dataSourceListSelect(d, vpcList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("vpc2s")
+	if err := d.Set("vpc2s", vpcList); err != nil {
+		return diag.Errorf("error setting `vpc2s`: %#v", err)
+	}
+
+	return nil
+}
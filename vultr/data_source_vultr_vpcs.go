@@ -0,0 +1,74 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrVPCs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrVPCsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			"limit":  dataSourceLimitSchema(),
+			"vpcs":   dataSourceListSchema(dataSourceVultrVPC()),
+		},
+	}
+}
+
+func dataSourceVultrVPCsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	vpcList := make([]interface{}, 0)
+	f, err := dataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		vpcs, meta, _, err := client.VPC.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("error getting VPCs: %v", err)
+		}
+
+		for i := range vpcs {
+			ok, err := filterMatches(f, vpcs[i])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				vpcList = append(vpcList, map[string]interface{}{
+					"id":             vpcs[i].ID,
+					"region":         vpcs[i].Region,
+					"description":    vpcs[i].Description,
+					"date_created":   vpcs[i].DateCreated,
+					"v4_subnet":      vpcs[i].V4Subnet,
+					"v4_subnet_mask": vpcs[i].V4SubnetMask,
+				})
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	vpcList, err = dataSourceListSelect(d, vpcList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("vpcs")
+	if err := d.Set("vpcs", vpcList); err != nil {
+		return diag.Errorf("error setting `vpcs`: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVultrVPCs(t *testing.T) {
+	t.Parallel()
+	description := acctest.RandomWithPrefix("tf-vpc-ds")
+	name := "data.vultr_vpcs.vpcs"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrVPCsConfig(description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "vpcs.#", "1"),
+					resource.TestCheckResourceAttr(name, "vpcs.0.description", description),
+					resource.TestCheckResourceAttr(name, "vpcs.0.region", "ewr"),
+					resource.TestCheckResourceAttrSet(name, "vpcs.0.v4_subnet"),
+					resource.TestCheckResourceAttrSet(name, "vpcs.0.v4_subnet_mask"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVultrVPCsConfig(description string) string {
+	return fmt.Sprintf(`
+		resource "vultr_vpc" "foo" {
+			region = "ewr"
+			description = "%s"
+		}
+
+		data "vultr_vpcs" "vpcs" {
+			filter {
+				name = "description"
+				values = [vultr_vpc.foo.description]
+			}
+		}
+		`, description)
+}
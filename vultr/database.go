@@ -1,6 +1,13 @@
 package vultr
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -221,3 +228,214 @@ func flattenUserACL(dbUser *govultr.DatabaseUser) []map[string]interface{} {
 	}
 	return f
 }
+
+// databaseRestartOptions lists the advanced options of each engine that only
+// take effect once the database restarts. The API doesn't flag them, so they
+// follow the engine documentation on a best-effort basis: an option missing
+// here is still applied, it is just not reported in restart_required_options.
+var databaseRestartOptions = map[string]map[string]bool{
+	"pg": {
+		"autovacuum_max_workers":          true,
+		"max_files_per_process":           true,
+		"max_locks_per_transaction":       true,
+		"max_logical_replication_workers": true,
+		"max_pred_locks_per_transaction":  true,
+		"max_prepared_transactions":       true,
+		"max_replication_slots":           true,
+		"max_wal_senders":                 true,
+		"max_worker_processes":            true,
+		"track_activity_query_size":       true,
+		"track_commit_timestamp":          true,
+	},
+	"mysql": {
+		"innodb_ft_min_token_size": true,
+		"innodb_read_io_threads":   true,
+		"innodb_write_io_threads":  true,
+	},
+}
+
+// listDatabases returns every database of the account. govultr doesn't take
+// a cursor for databases, so the pages are followed here.
+func listDatabases(ctx context.Context, client *govultr.Client) ([]govultr.Database, error) {
+	var databases []govultr.Database
+	cursor := ""
+	for {
+		req, err := client.NewRequest(ctx, http.MethodGet, "/v2/databases", nil)
+		if err != nil {
+			return nil, err
+		}
+		if cursor != "" {
+			req.URL.RawQuery = url.Values{"cursor": {cursor}}.Encode()
+		}
+
+		page := &struct {
+			Databases []govultr.Database `json:"databases"`
+			Meta      *govultr.Meta      `json:"meta"`
+		}{}
+		if _, err := client.DoWithContext(ctx, req, page); err != nil {
+			return nil, err
+		}
+		databases = append(databases, page.Databases...)
+
+		if page.Meta == nil || page.Meta.Links == nil || page.Meta.Links.Next == "" {
+			return databases, nil
+		}
+		cursor = page.Meta.Links.Next
+	}
+}
+
+// databaseAdvancedOptions is the advanced options response of a database.
+// The configured options are kept as a map so that options govultr doesn't
+// know about yet can still be managed.
+type databaseAdvancedOptions struct {
+	ConfiguredOptions map[string]interface{}    `json:"configured_options"`
+	AvailableOptions  []govultr.AvailableOption `json:"available_options"`
+}
+
+func getDatabaseAdvancedOptions(ctx context.Context, client *govultr.Client, databaseID string) (*databaseAdvancedOptions, error) { //nolint:lll
+	return doDatabaseAdvancedOptions(ctx, client, http.MethodGet, databaseID, nil)
+}
+
+func updateDatabaseAdvancedOptions(ctx context.Context, client *govultr.Client, databaseID string, options map[string]interface{}) (*databaseAdvancedOptions, error) { //nolint:lll
+	return doDatabaseAdvancedOptions(ctx, client, http.MethodPut, databaseID, options)
+}
+
+func doDatabaseAdvancedOptions(ctx context.Context, client *govultr.Client, method, databaseID string, body interface{}) (*databaseAdvancedOptions, error) { //nolint:lll
+	req, err := client.NewRequest(ctx, method, fmt.Sprintf("/v2/databases/%s/advanced-options", databaseID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &databaseAdvancedOptions{}
+	if _, err := client.DoWithContext(ctx, req, options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// expandDatabaseAdvancedOptions converts the configured option values to the
+// types of the available options, checking names, enumerals and ranges
+func expandDatabaseAdvancedOptions(engine string, raw map[string]interface{}, available []govultr.AvailableOption) (map[string]interface{}, error) { //nolint:lll
+	byName := map[string]govultr.AvailableOption{}
+	for i := range available {
+		byName[available[i].Name] = available[i]
+	}
+
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	options := map[string]interface{}{}
+	for _, name := range names {
+		option, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%q is not an advanced option of the %s engine", name, engine)
+		}
+
+		value, err := databaseAdvancedOptionValue(&option, raw[name].(string))
+		if err != nil {
+			return nil, fmt.Errorf("advanced option %q %v", name, err)
+		}
+		options[name] = value
+	}
+
+	return options, nil
+}
+
+func databaseAdvancedOptionValue(option *govultr.AvailableOption, value string) (interface{}, error) {
+	var number float64
+	var result interface{}
+	switch option.Type {
+	case "int", "integer":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be an integer, got %q", value)
+		}
+		for _, alt := range option.AltValues {
+			if n == alt {
+				return n, nil
+			}
+		}
+		number, result = float64(n), n
+	case "float", "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number, got %q", value)
+		}
+		number, result = f, f
+	case "bool", "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be true or false, got %q", value)
+		}
+		return b, nil
+	default:
+		if len(option.Enumerals) != 0 && !versionCompare(option.Enumerals, value) {
+			return nil, fmt.Errorf("must be one of %s, got %q", strings.Join(option.Enumerals, ", "), value)
+		}
+		return value, nil
+	}
+
+	if option.MinValue != nil && number < float64(*option.MinValue) {
+		return nil, fmt.Errorf("must be at least %v, got %s", *option.MinValue, value)
+	}
+	if option.MaxValue != nil && number > float64(*option.MaxValue) {
+		return nil, fmt.Errorf("must be at most %v, got %s", *option.MaxValue, value)
+	}
+
+	return result, nil
+}
+
+// flattenDatabaseAdvancedOptions returns the configured values of the given
+// option names, leaving out options that are no longer set
+func flattenDatabaseAdvancedOptions(configured map[string]interface{}, names []string) map[string]string {
+	options := map[string]string{}
+	for _, name := range names {
+		switch v := configured[name].(type) {
+		case nil:
+		case float64:
+			options[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			options[name] = strconv.FormatBool(v)
+		default:
+			options[name] = fmt.Sprint(v)
+		}
+	}
+
+	return options
+}
+
+// databaseRestartRequired returns the sorted names of the options that need
+// a restart of the database to take effect
+func databaseRestartRequired(engine string, options map[string]interface{}) []string {
+	names := []string{}
+	for name := range options {
+		if databaseRestartOptions[engine][name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// suppressDatabaseAdvancedOptionDiff ignores differences in how numbers and
+// booleans of an option are written, such as 0.20 and 0.2
+func suppressDatabaseAdvancedOptionDiff(k, old, new string, _ *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") || old == "" || new == "" {
+		return false
+	}
+	if o, err := strconv.ParseFloat(old, 64); err == nil {
+		n, err := strconv.ParseFloat(new, 64)
+		return err == nil && o == n
+	}
+	if o, err := strconv.ParseBool(old); err == nil {
+		n, err := strconv.ParseBool(new)
+		return err == nil && o == n
+	}
+
+	return false
+}
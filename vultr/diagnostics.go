@@ -0,0 +1,91 @@
+package vultr
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiErrorAttribute maps the wording of an API validation message to the
+// resource attributes it may refer to, in order of preference
+type apiErrorAttribute struct {
+	pattern    *regexp.Regexp
+	attributes []string
+}
+
+var apiErrorAttributes = []apiErrorAttribute{
+	{regexp.MustCompile(`(?i)\bos(_id)?\b|operating system`), []string{"os_id"}},
+	{regexp.MustCompile(`(?i)\bapp(_id|lication)?\b`), []string{"app_id"}},
+	{regexp.MustCompile(`(?i)\bimage(_id)?\b`), []string{"image_id"}},
+	{regexp.MustCompile(`(?i)\bsnapshot(_id)?\b`), []string{"snapshot_id"}},
+	{regexp.MustCompile(`(?i)\biso(_id)?\b`), []string{"iso_id"}},
+	{regexp.MustCompile(`(?i)\b(startup )?script(_id)?\b`), []string{"script_id"}},
+	{regexp.MustCompile(`(?i)ssh[ _]key`), []string{"ssh_key_ids"}},
+	{regexp.MustCompile(`(?i)(reserved|floating) ?ip`), []string{"reserved_ip_id"}},
+	{regexp.MustCompile(`(?i)firewall group`), []string{"firewall_group_id"}},
+	{regexp.MustCompile(`(?i)firewall rule`), []string{"firewall_rules"}},
+	{regexp.MustCompile(`(?i)forwarding rule`), []string{"forwarding_rules"}},
+	{regexp.MustCompile(`(?i)health ?check`), []string{"health_check"}},
+	{regexp.MustCompile(`(?i)certificate|private key|\bssl\b`), []string{"ssl"}},
+	{regexp.MustCompile(`(?i)node ?pool|node_quantity|nodes`), []string{"node_pools", "node_quantity"}},
+	{regexp.MustCompile(`(?i)\bvpc2?(_id)?\b`), []string{"vpc_id", "vpc_ids", "vpc2_ids", "vpc"}},
+	{regexp.MustCompile(`(?i)trusted ip`), []string{"trusted_ips"}},
+	{regexp.MustCompile(`(?i)\bengine\b`), []string{"database_engine"}},
+	{regexp.MustCompile(`(?i)\bversion\b`), []string{"database_engine_version", "version"}},
+	{regexp.MustCompile(`(?i)maintenance`), []string{"maintenance_dow", "maintenance_time"}},
+	{regexp.MustCompile(`(?i)\bplan\b`), []string{"plan"}},
+	{regexp.MustCompile(`(?i)\b(region|location)\b`), []string{"region"}},
+	{regexp.MustCompile(`(?i)\bhostname\b`), []string{"hostname"}},
+	{regexp.MustCompile(`(?i)\blabel\b`), []string{"label"}},
+	{regexp.MustCompile(`(?i)\btags?\b`), []string{"tags", "tag"}},
+	{regexp.MustCompile(`(?i)user[ _]?data`), []string{"user_data"}},
+}
+
+// apiErrorAttributePath returns the attribute of the resource schema that an
+// API validation message refers to
+func apiErrorAttributePath(message string, resourceSchema map[string]*schema.Schema) (cty.Path, bool) {
+	for _, a := range apiErrorAttributes {
+		if !a.pattern.MatchString(message) {
+			continue
+		}
+
+		for _, attr := range a.attributes {
+			if _, ok := resourceSchema[attr]; ok {
+				return cty.GetAttrPath(attr), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// apiErrorDiag converts an API error into a diagnostic with the action that
+// failed as the summary and the API message as the detail. Validation errors
+// that name an attribute of the resource point Terraform at that attribute.
+func apiErrorDiag(err error, resourceSchema map[string]*schema.Schema, summary string, args ...interface{}) diag.Diagnostics { //nolint:lll
+	apiErr := parseAPIError(err)
+	if apiErr == nil {
+		return nil
+	}
+
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(summary, args...),
+		Detail:   apiErr.Message,
+	}
+
+	if apiErr.Status != 0 {
+		d.Detail = fmt.Sprintf("%s (HTTP %d)", apiErr.Message, apiErr.Status)
+	}
+
+	if classifyAPIError(err) == apiErrorValidation {
+		if path, ok := apiErrorAttributePath(apiErr.Message, resourceSchema); ok {
+			d.AttributePath = path
+		}
+	}
+
+	return diag.Diagnostics{d}
+}
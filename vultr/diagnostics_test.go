@@ -0,0 +1,99 @@
+package vultr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestAPIErrorDiag(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		resource string
+		wantPath cty.Path
+		detail   string
+	}{
+		{
+			name:     "instance plan",
+			err:      errors.New(`{"error":"Invalid plan chosen.","status":400}`),
+			resource: "vultr_instance",
+			wantPath: cty.GetAttrPath("plan"),
+			detail:   "Invalid plan chosen. (HTTP 400)",
+		},
+		{
+			name:     "instance os",
+			err:      errors.New(`{"error":"Invalid os_id","status":400}`),
+			resource: "vultr_instance",
+			wantPath: cty.GetAttrPath("os_id"),
+			detail:   "Invalid os_id (HTTP 400)",
+		},
+		{
+			name:     "bare metal region",
+			err:      errors.New(`{"error":"Region is not available","status":400}`),
+			resource: "vultr_bare_metal_server",
+			wantPath: cty.GetAttrPath("region"),
+			detail:   "Region is not available (HTTP 400)",
+		},
+		{
+			name:     "database version",
+			err:      errors.New(`{"error":"Version 99 is not supported","status":400}`),
+			resource: "vultr_database",
+			wantPath: cty.GetAttrPath("database_engine_version"),
+			detail:   "Version 99 is not supported (HTTP 400)",
+		},
+		{
+			name:     "kubernetes version",
+			err:      errors.New(`{"error":"Invalid version provided","status":400}`),
+			resource: "vultr_kubernetes",
+			wantPath: cty.GetAttrPath("version"),
+			detail:   "Invalid version provided (HTTP 400)",
+		},
+		{
+			name:     "load balancer forwarding rules",
+			err:      errors.New(`{"error":"Duplicate forwarding rule","status":400}`),
+			resource: "vultr_load_balancer",
+			wantPath: cty.GetAttrPath("forwarding_rules"),
+			detail:   "Duplicate forwarding rule (HTTP 400)",
+		},
+		{
+			name:     "server error has no path",
+			err:      errors.New(`{"error":"Invalid plan chosen.","status":500}`),
+			resource: "vultr_instance",
+			detail:   "Invalid plan chosen. (HTTP 500)",
+		},
+		{
+			name:     "unmatched message",
+			err:      errors.New(`{"error":"Something went wrong","status":400}`),
+			resource: "vultr_instance",
+			detail:   "Something went wrong (HTTP 400)",
+		},
+		{
+			name:     "not an api error",
+			err:      errors.New("connection reset by peer"),
+			resource: "vultr_instance",
+			detail:   "connection reset by peer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := apiErrorDiag(tt.err, Provider().ResourcesMap[tt.resource].Schema, "error creating %s", "thing")
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got %d", len(diags))
+			}
+
+			d := diags[0]
+			if d.Summary != "error creating thing" {
+				t.Errorf("Summary = %q", d.Summary)
+			}
+			if d.Detail != tt.detail {
+				t.Errorf("Detail = %q, want %q", d.Detail, tt.detail)
+			}
+			if !d.AttributePath.Equals(tt.wantPath) {
+				t.Errorf("AttributePath = %#v, want %#v", d.AttributePath, tt.wantPath)
+			}
+		})
+	}
+}
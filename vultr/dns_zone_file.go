@@ -0,0 +1,474 @@
+package vultr
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// defaultZoneTTL is used for records of a zone file without a TTL or $TTL
+const defaultZoneTTL = 3600
+
+// maxZoneStringLen is the length limit in bytes of a character string
+const maxZoneStringLen = 255
+
+// parseZoneFile reads the records of an RFC 1035 zone file for a domain into
+// the form of the vultr_dns_zone_records record set. SOA records are skipped
+// since Vultr manages them, as are the apex NS records with skipManaged.
+// Directives other than $ORIGIN and $TTL are not supported.
+func parseZoneFile(zone, domain string, skipManaged bool) ([]map[string]interface{}, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	origin := domain + "."
+	ttl := defaultZoneTTL
+	owner := ""
+	haveOwner := false
+
+	var records []map[string]interface{}
+	var tokens []string
+	var blankOwner bool
+	depth, start := 0, 0
+
+	scanner := bufio.NewScanner(strings.NewReader(zone))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		lineTokens, opened, err := tokenizeZoneLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+
+		if depth == 0 {
+			if len(lineTokens) == 0 && opened == 0 {
+				continue
+			}
+			start = line
+			blankOwner = text != "" && (text[0] == ' ' || text[0] == '\t')
+		}
+		tokens = append(tokens, lineTokens...)
+		if depth += opened; depth > 0 {
+			continue
+		}
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+		}
+
+		entry := tokens
+		tokens = nil
+		if len(entry) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(entry[0]) {
+		case "$ORIGIN":
+			if len(entry) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN takes one domain name", start)
+			}
+			origin = absoluteZoneName(entry[1], origin)
+			continue
+		case "$TTL":
+			if len(entry) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL takes one value", start)
+			}
+			if ttl, err = parseZoneTTL(entry[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %v", start, err)
+			}
+			continue
+		}
+		if strings.HasPrefix(entry[0], "$") {
+			return nil, fmt.Errorf("line %d: unsupported directive %s", start, entry[0])
+		}
+
+		if !blankOwner {
+			owner = absoluteZoneName(entry[0], origin)
+			haveOwner = true
+			entry = entry[1:]
+		} else if !haveOwner {
+			return nil, fmt.Errorf("line %d: record without an owner name", start)
+		}
+
+		record, err := parseZoneRecord(entry, owner, origin, domain, ttl)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", start, err)
+		}
+		if record == nil || (skipManaged && record["type"] == "NS" && record["name"] == "") {
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", start)
+	}
+
+	return records, nil
+}
+
+// parseZoneRecord reads the [ttl] [class] type rdata fields of a record. It
+// returns nil for the SOA record.
+func parseZoneRecord(fields []string, owner, origin, domain string, ttl int) (map[string]interface{}, error) {
+	for i := 0; i < 2 && len(fields) > 0; i++ {
+		if v, err := parseZoneTTL(fields[0]); err == nil {
+			ttl = v
+			fields = fields[1:]
+		} else if strings.EqualFold(fields[0], "IN") {
+			fields = fields[1:]
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("missing record type")
+	}
+
+	name, err := relativeZoneName(owner, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	recordType, rdata := strings.ToUpper(fields[0]), fields[1:]
+	record := map[string]interface{}{"name": name, "type": recordType, "ttl": ttl, "priority": 0}
+
+	want := map[string]int{"A": 1, "AAAA": 1, "CNAME": 1, "NS": 1, "MX": 2, "SRV": 4, "CAA": 3}
+	if n, ok := want[recordType]; ok && len(rdata) != n {
+		return nil, fmt.Errorf("%s record takes %d value(s), got %d", recordType, n, len(rdata))
+	}
+
+	switch recordType {
+	case "SOA":
+		return nil, nil
+	case "A", "AAAA":
+		ip := net.ParseIP(rdata[0])
+		if ip == nil || (ip.To4() != nil) != (recordType == "A") {
+			return nil, fmt.Errorf("invalid %s record address %q", recordType, rdata[0])
+		}
+		record["data"] = rdata[0]
+	case "CNAME", "NS":
+		record["data"] = zoneHost(rdata[0], origin)
+	case "MX":
+		priority, err := strconv.Atoi(rdata[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid MX priority %q", rdata[0])
+		}
+		record["priority"] = priority
+		record["data"] = zoneHost(rdata[1], origin)
+	case "SRV":
+		priority, err := strconv.Atoi(rdata[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid SRV priority %q", rdata[0])
+		}
+		record["priority"] = priority
+		record["data"] = fmt.Sprintf("%s %s %s", rdata[1], rdata[2], zoneHost(rdata[3], origin))
+	case "TXT":
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("TXT record without data")
+		}
+		record["data"] = unquoteTXT(strings.Join(rdata, " "))
+	case "CAA":
+		record["data"] = strings.Join(rdata, " ")
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	return record, nil
+}
+
+// tokenizeZoneLine splits a zone file line into fields, keeping quoted
+// strings whole and dropping comments. It also returns the change in
+// parenthesis depth so records can span lines.
+func tokenizeZoneLine(line string) ([]string, int, error) {
+	var tokens []string
+	var b strings.Builder
+	depth := 0
+	quoted := false
+
+	flush := func() {
+		if b.Len() > 0 {
+			tokens = append(tokens, b.String())
+			b.Reset()
+		}
+	}
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quoted:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(line) {
+				i++
+				b.WriteByte(line[i])
+			} else if c == '"' {
+				quoted = false
+				flush()
+			}
+		case c == '"':
+			flush()
+			quoted = true
+			b.WriteByte(c)
+		case c == ';':
+			flush()
+			return tokens, depth, nil
+		case c == '(' || c == ')':
+			flush()
+			if c == '(' {
+				depth++
+			} else {
+				depth--
+			}
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+
+	return tokens, depth, nil
+}
+
+// parseZoneTTL reads a TTL in seconds, or with the BIND s, m, h, d and w
+// units such as 1h30m
+func parseZoneTTL(v string) (int, error) {
+	if v == "" || v[0] < '0' || v[0] > '9' {
+		return 0, fmt.Errorf("invalid TTL %q", v)
+	}
+	if n, err := strconv.Atoi(v); err == nil {
+		return n, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, n := 0, -1
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if c >= '0' && c <= '9' {
+			if n < 0 {
+				n = 0
+			}
+			n = n*10 + int(c-'0')
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || n < 0 {
+			return 0, fmt.Errorf("invalid TTL %q", v)
+		}
+		total += n * unit
+		n = -1
+	}
+	if n >= 0 {
+		return 0, fmt.Errorf("invalid TTL %q", v)
+	}
+
+	return total, nil
+}
+
+func absoluteZoneName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// relativeZoneName returns an absolute owner name relative to the domain, as
+// Vultr names records
+func relativeZoneName(name, domain string) (string, error) {
+	if name == domain+"." {
+		return "", nil
+	}
+	if rel, ok := strings.CutSuffix(name, "."+domain+"."); ok {
+		return rel, nil
+	}
+
+	return "", fmt.Errorf("%s is outside of %s", name, domain)
+}
+
+func zoneHost(name, origin string) string {
+	return strings.TrimSuffix(absoluteZoneName(name, origin), ".")
+}
+
+// unquoteTXT joins the quoted strings of TXT data. Data that isn't quoted is
+// returned as is.
+func unquoteTXT(data string) string {
+	tokens, _, err := tokenizeZoneLine(data)
+	if err != nil || len(tokens) == 0 {
+		return data
+	}
+
+	var b strings.Builder
+	for _, t := range tokens {
+		if len(t) < 2 || t[0] != '"' || t[len(t)-1] != '"' {
+			return data
+		}
+		s, err := unescapeZoneString(t[1 : len(t)-1])
+		if err != nil {
+			return data
+		}
+		b.WriteString(s)
+	}
+
+	return b.String()
+}
+
+// unescapeZoneString resolves the RFC 1035 escapes of a character string,
+// \DDD for a byte in decimal and \X for a literal X
+func unescapeZoneString(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 == len(s) {
+			return "", fmt.Errorf("trailing backslash")
+		}
+		if i+3 < len(s) && isZoneDigits(s[i+1:i+4]) {
+			n, _ := strconv.Atoi(s[i+1 : i+4])
+			if n > maxZoneStringLen {
+				return "", fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+			}
+			b.WriteByte(byte(n))
+			i += 3
+			continue
+		}
+		i++
+		b.WriteByte(s[i])
+	}
+
+	return b.String(), nil
+}
+
+func isZoneDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// renderZoneFile writes records of a domain as a BIND zone file, sorted by
+// name and type so the output is stable
+func renderZoneFile(domain string, records []map[string]interface{}) string {
+	domain = strings.TrimSuffix(domain, ".")
+	sorted := make([]map[string]interface{}, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a["name"] != b["name"] {
+			return a["name"].(string) < b["name"].(string)
+		}
+		if a["type"] != b["type"] {
+			return a["type"].(string) < b["type"].(string)
+		}
+		return dnsZoneRecordKey(a) < dnsZoneRecordKey(b)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n$TTL %d\n", domain, defaultZoneTTL)
+	for _, r := range sorted {
+		// the SOA is Vultr's and the parser skips it too
+		if r["type"] == "SOA" {
+			continue
+		}
+
+		owner := r["name"].(string)
+		if owner == "" {
+			owner = "@"
+		}
+
+		data := r["data"].(string)
+		switch r["type"] {
+		case "CNAME", "NS":
+			data = fqdnZoneHost(data)
+		case "MX":
+			data = fmt.Sprintf("%v %s", r["priority"], fqdnZoneHost(data))
+		case "SRV":
+			if fields := strings.Fields(data); len(fields) == 3 {
+				data = fmt.Sprintf("%v %s %s %s", r["priority"], fields[0], fields[1], fqdnZoneHost(fields[2]))
+			}
+		case "TXT":
+			data = quoteTXT(unquoteTXT(data))
+		}
+
+		fmt.Fprintf(&b, "%s\t%v\tIN\t%s\t%s\n", owner, r["ttl"], r["type"], data)
+	}
+
+	return b.String()
+}
+
+func fqdnZoneHost(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// quoteTXT quotes TXT data as character strings of at most 255 bytes, split
+// between runes. Quotes and backslashes are escaped with a backslash and
+// control characters as \DDD, as in RFC 1035.
+func quoteTXT(data string) string {
+	var parts []string
+	for len(data) > maxZoneStringLen {
+		n := maxZoneStringLen
+		for n > 0 && !utf8.RuneStart(data[n]) {
+			n--
+		}
+		if n == 0 {
+			n = maxZoneStringLen
+		}
+		parts = append(parts, escapeZoneString(data[:n]))
+		data = data[n:]
+	}
+
+	return strings.Join(append(parts, escapeZoneString(data)), " ")
+}
+
+func escapeZoneString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c == 0x7f: //nolint:mnd
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// sameZoneRecords reports whether two record lists hold the same records,
+// ignoring order
+func sameZoneRecords(a, b []map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	keys := map[string]int{}
+	for _, r := range a {
+		keys[dnsZoneRecordKey(r)]++
+	}
+	for _, r := range b {
+		k := dnsZoneRecordKey(r)
+		if keys[k] == 0 {
+			return false
+		}
+		keys[k]--
+	}
+
+	return true
+}
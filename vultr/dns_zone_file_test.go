@@ -0,0 +1,173 @@
+package vultr
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.other.net. admin.example.com. (
+		2024010101 ; serial
+		7200 3600 1209600 300 )
+@		IN	NS	ns1.other.net.
+@	300	IN	A	192.0.2.1
+		IN	AAAA	2001:db8::1
+www		IN	CNAME	@
+mail	600	IN	A	192.0.2.25
+@		IN	MX	10 mail
+@		IN	TXT	"v=spf1 mx -all" ; spf
+_sip._tcp	IN	SRV	10 5 5060 sip.example.com.
+@		IN	CAA	0 issue "letsencrypt.org"
+$ORIGIN dev.example.com.
+api	1d	IN	CNAME	lb.example.net.
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := parseZoneFile(testZoneFile, "example.com", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"|A|192.0.2.1|300|0",
+		"|AAAA|2001:db8::1|3600|0",
+		"www|CNAME|example.com|3600|0",
+		"mail|A|192.0.2.25|600|0",
+		"|MX|mail.example.com|3600|10",
+		"|TXT|v=spf1 mx -all|3600|0",
+		"_sip._tcp|SRV|5 5060 sip.example.com|3600|10",
+		`|CAA|0 issue "letsencrypt.org"|3600|0`,
+		"api.dev|CNAME|lb.example.net|86400|0",
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d: %v", len(records), len(want), records)
+	}
+	for i := range want {
+		if got := dnsZoneRecordKey(records[i]); got != want[i] {
+			t.Errorf("record %d = %s, want %s", i, got, want[i])
+		}
+	}
+
+	// the apex NS records come through when they aren't left to Vultr
+	all, err := parseZoneFile(testZoneFile, "example.com", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != len(want)+1 || all[0]["type"] != "NS" || all[0]["data"] != "ns1.other.net" {
+		t.Errorf("expected the apex NS record first, got %v", all)
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := []struct {
+		zone    string
+		wantErr string
+	}{
+		{"@ IN A 2001:db8::1", "line 1: invalid A record address"},
+		{"@ IN MX mail", "line 1: MX record takes 2 value(s), got 1"},
+		{"@ IN HINFO a b", "line 1: unsupported record type HINFO"},
+		{"www.example.net. IN A 192.0.2.1", "line 1: www.example.net. is outside of example.com"},
+		{"$INCLUDE other.zone", "line 1: unsupported directive $INCLUDE"},
+		{"\n  IN A 192.0.2.1", "line 2: record without an owner name"},
+		{`@ IN TXT "open`, "line 1: unterminated quoted string"},
+		{"@ IN SOA a. b. ( 1 2 3 4", "unbalanced parentheses"},
+		{"$TTL 1x", "line 1: invalid TTL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantErr, func(t *testing.T) {
+			_, err := parseZoneFile(tt.zone, "example.com", true)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	records, err := parseZoneFile(testZoneFile, "example.com", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zone := renderZoneFile("example.com", records)
+	for _, line := range []string{
+		"$ORIGIN example.com.\n",
+		"@\t3600\tIN\tMX\t10 mail.example.com.\n",
+		"@\t3600\tIN\tTXT\t\"v=spf1 mx -all\"\n",
+		"_sip._tcp\t3600\tIN\tSRV\t10 5 5060 sip.example.com.\n",
+		"api.dev\t86400\tIN\tCNAME\tlb.example.net.\n",
+	} {
+		if !strings.Contains(zone, line) {
+			t.Errorf("zone file is missing %q:\n%s", line, zone)
+		}
+	}
+
+	// rendering and parsing again gives the same records
+	again, err := parseZoneFile(zone, "example.com", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameZoneRecords(records, again) {
+		t.Fatalf("round trip changed the records:\n%v\n%v", records, again)
+	}
+}
+
+func TestQuoteTXT(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`v=spf1 -all`, `"v=spf1 -all"`},
+		{`say "hi" \ bye`, `"say \"hi\" \\ bye"`},
+		{"tab\there\x7f", `"tab\009here\127"`},
+		{"héllo", `"héllo"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			got := quoteTXT(tt.data)
+			if got != tt.want {
+				t.Fatalf("quoteTXT(%q) = %s, want %s", tt.data, got, tt.want)
+			}
+			if back := unquoteTXT(got); back != tt.data {
+				t.Fatalf("unquoteTXT(%s) = %q, want %q", got, back, tt.data)
+			}
+		})
+	}
+
+	// long data is split between runes, never inside one
+	long := strings.Repeat("a", 254) + strings.Repeat("é", 200)
+	quoted := quoteTXT(long)
+	tokens, _, err := tokenizeZoneLine(quoted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 3 || tokens[0] != `"`+strings.Repeat("a", 254)+`"` {
+		t.Fatalf("unexpected split of long data: %v", tokens)
+	}
+	for _, token := range tokens {
+		if s := strings.Trim(token, `"`); len(s) > maxZoneStringLen || !utf8.ValidString(s) {
+			t.Errorf("character string %q is not valid", s)
+		}
+	}
+	if back := unquoteTXT(quoted); back != long {
+		t.Fatalf("round trip of long data gave %q", back)
+	}
+}
+
+func TestRenderZoneFileSkipsSOA(t *testing.T) {
+	zone := renderZoneFile("example.com", []map[string]interface{}{
+		{"name": "", "type": "SOA", "data": "ns1.vultr.com dnsadm.vultr.com", "ttl": 300, "priority": 0},
+		{"name": "", "type": "NS", "data": "ns1.vultr.com", "ttl": 300, "priority": 0},
+	})
+
+	if strings.Contains(zone, "SOA") {
+		t.Fatalf("expected no SOA record in the zone file:\n%s", zone)
+	}
+	if !strings.Contains(zone, "@\t300\tIN\tNS\tns1.vultr.com.\n") {
+		t.Fatalf("zone file is missing the NS record:\n%s", zone)
+	}
+}
@@ -0,0 +1,146 @@
+package vultr
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// apiErrorKind classifies an error returned by the Vultr API
+type apiErrorKind int
+
+const (
+	apiErrorUnknown apiErrorKind = iota
+	apiErrorNotFound
+	apiErrorConflict
+	apiErrorRateLimited
+	apiErrorTransient
+	apiErrorValidation
+	apiErrorUnauthorized
+)
+
+func (k apiErrorKind) String() string {
+	switch k {
+	case apiErrorNotFound:
+		return "not found"
+	case apiErrorConflict:
+		return "conflict"
+	case apiErrorRateLimited:
+		return "rate limited"
+	case apiErrorTransient:
+		return "transient"
+	case apiErrorValidation:
+		return "validation"
+	case apiErrorUnauthorized:
+		return "unauthorized"
+	default:
+		return "unknown"
+	}
+}
+
+// apiError is the error body returned by the Vultr API
+type apiError struct {
+	Status  int    `json:"status"`
+	Message string `json:"error"`
+}
+
+// Prefix govultr uses once retryablehttp has exhausted its retries
+var apiGaveUpPattern = regexp.MustCompile(`^gave up after \d+ attempts, last error: (".*")$`)
+
+// parseAPIError extracts the status and message from an error returned by
+// govultr. Errors that don't carry an API response body are returned with a
+// zero status and the error text as the message.
+func parseAPIError(err error) *apiError {
+	if err == nil {
+		return nil
+	}
+
+	body := err.Error()
+	if m := apiGaveUpPattern.FindStringSubmatch(body); m != nil {
+		if unquoted, uerr := strconv.Unquote(m[1]); uerr == nil {
+			body = unquoted
+		}
+	}
+
+	apiErr := &apiError{}
+	if i := strings.Index(body, "{"); i != -1 {
+		if jerr := json.Unmarshal([]byte(body[i:]), apiErr); jerr == nil && (apiErr.Status != 0 || apiErr.Message != "") {
+			return apiErr
+		}
+	}
+
+	return &apiError{Message: err.Error()}
+}
+
+// classifyAPIError returns the kind of failure behind an API error, going by
+// the HTTP status alone. Errors without a status are transient when govultr
+// gave up retrying them.
+func classifyAPIError(err error) apiErrorKind {
+	apiErr := parseAPIError(err)
+	if apiErr == nil {
+		return apiErrorUnknown
+	}
+
+	switch {
+	case apiErr.Status == http.StatusNotFound:
+		return apiErrorNotFound
+	case apiErr.Status == http.StatusUnauthorized, apiErr.Status == http.StatusForbidden:
+		return apiErrorUnauthorized
+	case apiErr.Status == http.StatusTooManyRequests:
+		return apiErrorRateLimited
+	case apiErr.Status == http.StatusConflict:
+		return apiErrorConflict
+	case apiErr.Status >= http.StatusInternalServerError:
+		return apiErrorTransient
+	case apiErr.Status >= http.StatusBadRequest:
+		return apiErrorValidation
+	case apiErr.Status == 0 && strings.HasPrefix(apiErr.Message, "gave up after"):
+		return apiErrorTransient
+	default:
+		return apiErrorUnknown
+	}
+}
+
+// isNotFoundError reports whether err means the requested resource no longer
+// exists
+func isNotFoundError(err error) bool {
+	return classifyAPIError(err) == apiErrorNotFound
+}
+
+// isConflictError reports whether err was caused by the state of another
+// resource, such as an attachment that hasn't been released yet
+func isConflictError(err error) bool {
+	return classifyAPIError(err) == apiErrorConflict
+}
+
+// isRetryableError reports whether the request that caused err may succeed
+// when it is sent again, because the API was rate limited or failed on its
+// side
+func isRetryableError(err error) bool {
+	kind := classifyAPIError(err)
+	return kind == apiErrorRateLimited || kind == apiErrorTransient
+}
+
+// isUnauthorizedError reports whether err was caused by the API key
+func isUnauthorizedError(err error) bool {
+	return classifyAPIError(err) == apiErrorUnauthorized
+}
+
+// apiErrorContains reports whether the message of an API error contains one
+// of msgs. A few requests fail with a 400 whose message alone tells that a
+// dependency is still busy, and their retry loops look for it.
+func apiErrorContains(err error, msgs ...string) bool {
+	apiErr := parseAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if strings.Contains(apiErr.Message, msg) {
+			return true
+		}
+	}
+	return false
+}
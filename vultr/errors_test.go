@@ -0,0 +1,65 @@
+package vultr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestClassifyAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want apiErrorKind
+	}{
+		{name: "nil", err: nil, want: apiErrorUnknown},
+		{name: "404 status", err: errors.New(`{"error":"instance not found","status":404}`), want: apiErrorNotFound},
+		{name: "invalid id", err: errors.New(`{"error":"Invalid block storage ID","status":400}`), want: apiErrorValidation},
+		{name: "not found message", err: errors.New(`{"error":"Subscription ID Not Found.","status":400}`), want: apiErrorValidation},                       //nolint:lll
+		{name: "block attached", err: errors.New(`{"error":"Block storage is already attached to another server","status":400}`), want: apiErrorValidation}, //nolint:lll
+		{name: "409 status", err: errors.New(`{"error":"Resource is busy","status":409}`), want: apiErrorConflict},
+		{name: "rate limited", err: errors.New(`{"error":"Rate limit exceeded","status":429}`), want: apiErrorRateLimited},
+		{name: "server error", err: errors.New(`{"error":"Internal error","status":500}`), want: apiErrorTransient},
+		{name: "gave up", err: fmt.Errorf("gave up after 4 attempts, last error: %#v", `{"error":"Bad gateway","status":502}`), want: apiErrorTransient}, //nolint:lll
+		{name: "gave up on connection", err: errors.New("gave up after 4 attempts, last error : dial tcp: connection refused"), want: apiErrorTransient}, //nolint:lll
+		{name: "unauthorized", err: errors.New(`{"error":"Invalid API token.","status":401}`), want: apiErrorUnauthorized},
+		{name: "unprocessable", err: errors.New(`{"error":"Plan not found in region","status":422}`), want: apiErrorValidation}, //nolint:lll
+		{name: "validation", err: errors.New(`{"error":"Invalid plan chosen.","status":400}`), want: apiErrorValidation},
+		{name: "not json", err: errors.New("unexpected end of JSON input"), want: apiErrorUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyAPIError(tt.err); got != tt.want {
+				t.Errorf("classifyAPIError(%v) = %s, want %s", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	for err, want := range map[error]bool{
+		errors.New(`{"error":"Rate limit exceeded","status":429}`):            true,
+		errors.New(`{"error":"Bad gateway","status":502}`):                    true,
+		errors.New(`{"error":"Resource is busy","status":409}`):               false,
+		errors.New(`{"error":"Load balancer is not ready.","status":400}`):    false,
+		errors.New("gave up after 4 attempts, last error : connection reset"): true,
+	} {
+		if got := isRetryableError(err); got != want {
+			t.Errorf("isRetryableError(%v) = %t, want %t", err, got, want)
+		}
+	}
+}
+
+func TestAPIErrorContains(t *testing.T) {
+	err := errors.New(`{"error":"Floating IPv4 address is already attached to another server","status":400}`)
+	if !apiErrorContains(err, "Block storage is already attached", "Floating IPv4 address is already attached") {
+		t.Errorf("expected %v to contain the reserved IP message", err)
+	}
+	if apiErrorContains(err, "Block storage is already attached") {
+		t.Errorf("expected %v not to contain the block storage message", err)
+	}
+	if apiErrorContains(nil, "attached") {
+		t.Error("expected no message for a nil error")
+	}
+}
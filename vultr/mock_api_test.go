@@ -0,0 +1,1446 @@
+package vultr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const mockAPIKey = "mock-api-key"
+
+// mockUnknownValue stands for a value that is only known at apply in a raw
+// resource configuration
+const mockUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// mockVultrAPI is a stateful, in-memory fake of the Vultr v2 REST API served
+// over httptest. Point a Config at it through APIEndpoint and the provider
+// runs its real CRUD code paths without a VULTR_API_KEY or network access.
+type mockVultrAPI struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	seq     int
+	kinds   []*mockKind
+	actions []*mockAction
+	items   map[string]map[string]map[string]interface{}
+	order   map[string][]string
+
+	// requests counts the calls served per "METHOD /path"
+	requests map[string]int
+}
+
+// mockKind describes a REST collection such as /v2/instances or the records
+// nested under /v2/domains/{}/records. Every {} segment matches any value.
+type mockKind struct {
+	path     string
+	single   string
+	plural   string
+	idKey    string
+	intID    bool
+	notFound string
+
+	// create fills in computed fields on a freshly inserted object
+	create func(m *mockVultrAPI, self string, obj map[string]interface{})
+	// update runs after the request body has been merged into the object
+	update func(m *mockVultrAPI, self string, obj, body map[string]interface{})
+	// render decorates a copy of the object before it is written out
+	render func(m *mockVultrAPI, self string, obj map[string]interface{})
+}
+
+// mockAction handles a route that isn't plain CRUD on a collection, such as
+// /v2/instances/{}/vpcs or /v2/kubernetes/clusters/{}/config.
+type mockAction struct {
+	method  string
+	path    string
+	handler func(m *mockVultrAPI, w http.ResponseWriter, r *http.Request, params []string, body map[string]interface{})
+}
+
+// newMockVultrAPI starts a fake API seeded with a small catalog of regions,
+// plans and operating systems. The server is closed when the test ends.
+func newMockVultrAPI(t *testing.T) *mockVultrAPI {
+	t.Helper()
+
+	m := &mockVultrAPI{
+		items:    map[string]map[string]map[string]interface{}{},
+		order:    map[string][]string{},
+		requests: map[string]int{},
+	}
+	m.registerCatalog()
+	m.registerInstances()
+	m.registerBlockStorage()
+	m.registerDNS()
+	m.registerFirewalls()
+	m.registerVPCs()
+	m.registerAccountResources()
+	m.registerLoadBalancers()
+	m.registerDatabases()
+	m.registerKubernetes()
+	m.registerCDN()
+
+	m.Server = httptest.NewServer(m)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// client returns a provider client that talks to the fake API
+func (m *mockVultrAPI) client(t *testing.T) *Client {
+	t.Helper()
+
+	config := Config{APIKey: mockAPIKey, APIEndpoint: m.URL, RetryLimit: 1}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error configuring mock client: %v", err)
+	}
+
+	return client
+}
+
+// providerFactories returns provider factories for resource.UnitTest. Each
+// test gets its own provider so parallel tests can use different endpoints.
+func (m *mockVultrAPI) providerFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"vultr": func() (*schema.Provider, error) {
+			return Provider(), nil
+		},
+	}
+}
+
+// providerConfig points the provider block of resource.UnitTest
+// configurations at the fake API.
+func (m *mockVultrAPI) providerConfig() string {
+	return fmt.Sprintf(`
+		provider "vultr" {
+			api_key      = %q
+			api_endpoint = %q
+		}`, mockAPIKey, m.URL)
+}
+
+func (m *mockVultrAPI) kind(k *mockKind) {
+	if k.idKey == "" {
+		k.idKey = "id"
+	}
+	m.kinds = append(m.kinds, k)
+}
+
+func (m *mockVultrAPI) action(method, path string, handler func(m *mockVultrAPI, w http.ResponseWriter, r *http.Request, params []string, body map[string]interface{})) { //nolint:lll
+	m.actions = append(m.actions, &mockAction{method: method, path: path, handler: handler})
+}
+
+func (m *mockVultrAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[r.Method+" "+strings.TrimSuffix(r.URL.Path, "/")]++
+
+	if r.Header.Get("Authorization") != "Bearer "+mockAPIKey {
+		mockError(w, http.StatusUnauthorized, "Invalid API token.")
+		return
+	}
+
+	body := map[string]interface{}{}
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err.Error() != "EOF" {
+			mockError(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON body: %v", err))
+			return
+		}
+	}
+
+	segs := splitMockPath(r.URL.Path)
+	for _, a := range m.actions {
+		if a.method != r.Method {
+			continue
+		}
+		if params, ok := matchMockPath(splitMockPath(a.path), segs); ok {
+			a.handler(m, w, r, params, body)
+			return
+		}
+	}
+
+	for _, k := range m.kinds {
+		pattern := splitMockPath(k.path)
+		if _, ok := matchMockPath(pattern, segs); ok {
+			m.serveCollection(w, r, k, "/"+strings.Join(segs, "/"), body)
+			return
+		}
+
+		if len(segs) == len(pattern)+1 {
+			if _, ok := matchMockPath(pattern, segs[:len(pattern)]); ok {
+				collection := "/" + strings.Join(segs[:len(pattern)], "/")
+				m.serveItem(w, r, k, collection, segs[len(pattern)], body)
+				return
+			}
+		}
+	}
+
+	mockError(w, http.StatusNotFound, "Invalid API route")
+}
+
+func (m *mockVultrAPI) serveCollection(w http.ResponseWriter, r *http.Request, k *mockKind, collection string, body map[string]interface{}) { //nolint:lll
+	switch r.Method {
+	case http.MethodGet:
+		var list []interface{}
+		for _, obj := range m.list(collection) {
+			if v := r.URL.Query().Get("type"); v != "" && v != "all" && obj["type"] != nil && obj["type"] != v {
+				continue
+			}
+			list = append(list, m.render(k, collection, obj))
+		}
+		if list == nil {
+			list = []interface{}{}
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{k.plural: list, "meta": mockMeta(len(list))})
+	case http.MethodPost:
+		obj := m.insert(k, collection, body)
+		if obj == nil {
+			mockError(w, http.StatusBadRequest, fmt.Sprintf("%s already exists", k.single))
+			return
+		}
+		mockJSON(w, http.StatusCreated, map[string]interface{}{k.single: m.render(k, collection, obj)})
+	default:
+		mockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (m *mockVultrAPI) serveItem(w http.ResponseWriter, r *http.Request, k *mockKind, collection, id string, body map[string]interface{}) { //nolint:lll
+	obj, ok := m.get(collection, id)
+	if !ok {
+		mockError(w, http.StatusNotFound, k.notFound)
+		return
+	}
+
+	self := collection + "/" + id
+	switch r.Method {
+	case http.MethodGet:
+		mockJSON(w, http.StatusOK, map[string]interface{}{k.single: m.render(k, collection, obj)})
+	case http.MethodPatch, http.MethodPut:
+		for key, val := range body {
+			if val != nil {
+				obj[key] = val
+			}
+		}
+		if k.update != nil {
+			k.update(m, self, obj, body)
+		}
+		obj["date_modified"] = mockTimestamp()
+		mockJSON(w, http.StatusOK, map[string]interface{}{k.single: m.render(k, collection, obj)})
+	case http.MethodDelete:
+		m.remove(collection, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		mockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// insert stores a new object built from the request body, returning nil when
+// the caller supplied an ID that is already taken.
+func (m *mockVultrAPI) insert(k *mockKind, collection string, body map[string]interface{}) map[string]interface{} {
+	obj := map[string]interface{}{}
+	for key, val := range body {
+		obj[key] = val
+	}
+
+	var id string
+	if v, ok := obj[k.idKey].(string); ok && v != "" {
+		id = v
+	} else if k.intID {
+		id = strconv.Itoa(m.nextSeq())
+		obj[k.idKey] = m.nextSeqValue(id)
+	} else {
+		id = m.newID()
+		obj[k.idKey] = id
+	}
+
+	if _, exists := m.get(collection, id); exists {
+		return nil
+	}
+
+	if _, ok := obj["date_created"]; !ok {
+		obj["date_created"] = mockTimestamp()
+	}
+
+	m.put(collection, id, obj)
+	if k.create != nil {
+		k.create(m, collection+"/"+id, obj)
+	}
+
+	return obj
+}
+
+func (m *mockVultrAPI) render(k *mockKind, collection string, obj map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for key, val := range obj {
+		if !strings.HasPrefix(key, "_") {
+			out[key] = val
+		}
+	}
+
+	if k.render != nil {
+		k.render(m, collection+"/"+fmt.Sprint(obj[k.idKey]), out)
+	}
+
+	return out
+}
+
+func (m *mockVultrAPI) put(collection, id string, obj map[string]interface{}) {
+	if m.items[collection] == nil {
+		m.items[collection] = map[string]map[string]interface{}{}
+	}
+	if _, ok := m.items[collection][id]; !ok {
+		m.order[collection] = append(m.order[collection], id)
+	}
+	m.items[collection][id] = obj
+}
+
+func (m *mockVultrAPI) get(collection, id string) (map[string]interface{}, bool) {
+	obj, ok := m.items[collection][id]
+	return obj, ok
+}
+
+func (m *mockVultrAPI) list(collection string) []map[string]interface{} {
+	var out []map[string]interface{}
+	for _, id := range m.order[collection] {
+		if obj, ok := m.items[collection][id]; ok {
+			out = append(out, obj)
+		}
+	}
+	return out
+}
+
+// remove deletes an object along with every collection nested beneath it
+func (m *mockVultrAPI) remove(collection, id string) {
+	delete(m.items[collection], id)
+
+	ids := m.order[collection][:0]
+	for _, v := range m.order[collection] {
+		if v != id {
+			ids = append(ids, v)
+		}
+	}
+	m.order[collection] = ids
+
+	prefix := collection + "/" + id + "/"
+	for key := range m.items {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.items, key)
+			delete(m.order, key)
+		}
+	}
+}
+
+func (m *mockVultrAPI) nextSeq() int {
+	m.seq++
+	return m.seq
+}
+
+func (m *mockVultrAPI) nextSeqValue(id string) interface{} {
+	n, _ := strconv.Atoi(id)
+	return n
+}
+
+func (m *mockVultrAPI) newID() string {
+	n := m.nextSeq()
+	return fmt.Sprintf("%08x-%04x-4000-8000-%012x", n, n, n)
+}
+
+// count returns how many objects are stored in a collection, for assertions
+// in tests that need to verify deletes actually reached the API.
+// requestCount returns how many times method and path have been requested
+func (m *mockVultrAPI) requestCount(method, path string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.requests[method+" "+path]
+}
+
+func (m *mockVultrAPI) count(collection string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.items[collection])
+}
+
+func (m *mockVultrAPI) registerCatalog() {
+	m.kind(&mockKind{path: "/v2/regions", single: "region", plural: "regions", notFound: "Invalid region"})
+	m.kind(&mockKind{path: "/v2/plans", single: "plan", plural: "plans", notFound: "Invalid plan"})
+	m.kind(&mockKind{path: "/v2/plans-metal", single: "plan", plural: "plans_metal", notFound: "Invalid plan"})
+	m.kind(&mockKind{path: "/v2/os", single: "os", plural: "os", intID: true, notFound: "Invalid OS"})
+	m.kind(&mockKind{path: "/v2/applications", single: "application", plural: "applications", intID: true, notFound: "Invalid application"}) //nolint:lll
+
+	for _, r := range []map[string]interface{}{
+		{"id": "ewr", "city": "New Jersey", "country": "US", "continent": "North America"},
+		{"id": "sea", "city": "Seattle", "country": "US", "continent": "North America"},
+		{"id": "ams", "city": "Amsterdam", "country": "NL", "continent": "Europe"},
+	} {
+		r["options"] = []interface{}{"ddos_protection", "block_storage_high_perf", "block_storage_storage_opt", "load_balancers", "kubernetes"} //nolint:lll
+		r["connectivity"] = []interface{}{}
+		m.put("/v2/regions", r["id"].(string), r)
+	}
+
+	for _, p := range []map[string]interface{}{
+		{"id": "vc2-1c-1gb", "vcpu_count": 1, "ram": 1024, "disk": 25, "disk_count": 1, "bandwidth": 1024, "monthly_cost": 5, "type": "vc2", "locations": []interface{}{"ewr", "sea", "ams"}},                                           //nolint:lll
+		{"id": "vc2-2c-4gb", "vcpu_count": 2, "ram": 4096, "disk": 80, "disk_count": 1, "bandwidth": 3072, "monthly_cost": 20, "type": "vc2", "locations": []interface{}{"ewr", "sea", "ams"}},                                          //nolint:lll
+		{"id": "vhf-2c-4gb", "vcpu_count": 2, "ram": 4096, "disk": 128, "disk_count": 1, "bandwidth": 3072, "monthly_cost": 24, "type": "vhf", "locations": []interface{}{"ewr", "ams"}},                                                //nolint:lll
+		{"id": "vcg-a100-1c-6g-4vram", "vcpu_count": 1, "ram": 6144, "disk": 70, "disk_count": 1, "bandwidth": 1024, "monthly_cost": 90, "type": "vcg", "gpu_vram_gb": 4, "gpu_type": "NVIDIA_A100", "locations": []interface{}{"ewr"}}, //nolint:lll
+	} {
+		m.put("/v2/plans", p["id"].(string), p)
+	}
+
+	m.action(http.MethodGet, "/v2/regions/{}/availability", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		if _, ok := m.get("/v2/regions", params[0]); !ok {
+			mockError(w, http.StatusNotFound, "Invalid region")
+			return
+		}
+
+		available := []interface{}{}
+		for _, collection := range []string{"/v2/plans", "/v2/plans-metal"} {
+			for _, plan := range m.list(collection) {
+				for _, l := range mockStringList(plan["locations"]) {
+					if l == params[0] {
+						available = append(available, plan["id"])
+					}
+				}
+			}
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"available_plans": available})
+	})
+
+	m.put("/v2/plans-metal", "vbm-4c-32gb", map[string]interface{}{
+		"id": "vbm-4c-32gb", "cpu_count": 4, "cpu_model": "E3-1270v6", "cpu_threads": 8, "ram": 32768, "disk": 240,
+		"disk_count": 2, "bandwidth": 5120, "monthly_cost": 120, "type": "SSD", "locations": []interface{}{"ewr", "ams"},
+	})
+
+	for _, o := range []map[string]interface{}{
+		{"id": 1743, "name": "Ubuntu 22.04 LTS x64", "arch": "x64", "family": "ubuntu"},
+		{"id": 2136, "name": "Debian 12 x64 (bookworm)", "arch": "x64", "family": "debian"},
+		{"id": 159, "name": "Custom", "arch": "x64", "family": "iso"},
+	} {
+		m.put("/v2/os", strconv.Itoa(o["id"].(int)), o)
+	}
+
+	m.put("/v2/applications", "2", map[string]interface{}{
+		"id": 2, "name": "WordPress on Ubuntu 22.04", "short_name": "wordpress", "deploy_name": "WordPress on Ubuntu 22.04",
+		"type": "one-click", "vendor": "vultr", "image_id": "",
+	})
+}
+
+func (m *mockVultrAPI) registerInstances() {
+	m.kind(&mockKind{
+		path: "/v2/instances", single: "instance", plural: "instances", notFound: "instance not found",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			n := m.seq
+			obj["status"] = "active"
+			obj["power_status"] = "running"
+			obj["server_status"] = "ok"
+			obj["main_ip"] = fmt.Sprintf("192.0.2.%d", n%250+1)
+			obj["netmask_v4"] = "255.255.254.0"
+			obj["gateway_v4"] = "192.0.2.254"
+			obj["internal_ip"] = ""
+			obj["kvm"] = "https://my.vultr.com/subs/vps/novnc/api.php?data=mock"
+			obj["default_password"] = "mock-P@ssw0rd"
+			obj["allowed_bandwidth"] = 1000
+			obj["features"] = []interface{}{}
+			obj["_vpcs"] = mockStringList(obj["attach_vpc"])
+			obj["_backups"] = map[string]interface{}{"enabled": obj["backups"] == "enabled"}
+
+			if obj["label"] == nil {
+				obj["label"] = ""
+			}
+			if obj["hostname"] == nil {
+				obj["hostname"] = "vultr-guest"
+			}
+			if obj["user_scheme"] == nil {
+				obj["user_scheme"] = "root"
+			}
+			if obj["enable_ipv6"] == true {
+				obj["v6_network"] = "2001:db8:1000::"
+				obj["v6_main_ip"] = fmt.Sprintf("2001:db8:1000::%x", n)
+				obj["v6_network_size"] = 64
+			}
+
+			switch {
+			case obj["snapshot_id"] != nil, obj["image_id"] != nil, obj["app_id"] != nil:
+				obj["os"] = "Application"
+			case obj["iso_id"] != nil:
+				obj["os_id"] = 159
+				obj["os"] = "Custom"
+			default:
+				if os, ok := m.get("/v2/os", fmt.Sprint(obj["os_id"])); ok {
+					obj["os"] = os["name"]
+				}
+			}
+
+			m.applyPlan(obj)
+		},
+		update: func(m *mockVultrAPI, self string, obj, body map[string]interface{}) {
+			vpcs := mockStringList(obj["_vpcs"])
+			vpcs = append(vpcs, mockStringList(body["attach_vpc"])...)
+			obj["_vpcs"] = mockWithout(vpcs, mockStringList(body["detach_vpc"]))
+
+			if enabled, ok := body["backups"].(string); ok {
+				obj["_backups"].(map[string]interface{})["enabled"] = enabled == "enabled"
+			}
+
+			m.applyPlan(obj)
+		},
+	})
+
+	m.action(http.MethodGet, "/v2/instances/{}/vpcs", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		instance, ok := m.get("/v2/instances", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+
+		vpcs := []interface{}{}
+		for i, id := range mockStringList(instance["_vpcs"]) {
+			vpcs = append(vpcs, map[string]interface{}{
+				"id":          id,
+				"mac_address": fmt.Sprintf("5a:01:04:00:00:%02x", i),
+				"ip_address":  fmt.Sprintf("10.1.96.%d", i+3),
+			})
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"vpcs": vpcs, "meta": mockMeta(len(vpcs))})
+	})
+
+	m.action(http.MethodGet, "/v2/instances/{}/vpc2", func(_ *mockVultrAPI, w http.ResponseWriter, _ *http.Request, _ []string, _ map[string]interface{}) { //nolint:lll
+		mockJSON(w, http.StatusOK, map[string]interface{}{"vpcs": []interface{}{}, "meta": mockMeta(0)})
+	})
+
+	m.action(http.MethodGet, "/v2/instances/{}/backup-schedule", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		instance, ok := m.get("/v2/instances", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"backup_schedule": instance["_backups"]})
+	})
+
+	m.action(http.MethodPost, "/v2/instances/{}/backup-schedule", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		instance, ok := m.get("/v2/instances", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+		body["enabled"] = true
+		instance["_backups"] = body
+		w.WriteHeader(http.StatusOK)
+	})
+
+	for _, action := range []string{"iso/attach", "iso/detach", "start", "halt", "reboot"} {
+		m.action(http.MethodPost, "/v2/instances/{}/"+action, func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+			if _, ok := m.get("/v2/instances", params[0]); !ok {
+				mockError(w, http.StatusNotFound, "instance not found")
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		})
+	}
+}
+
+// applyPlan copies the sizing of the object's plan from the seeded catalog
+func (m *mockVultrAPI) applyPlan(obj map[string]interface{}) {
+	plan, ok := m.get("/v2/plans", fmt.Sprint(obj["plan"]))
+	if !ok {
+		return
+	}
+
+	obj["ram"] = plan["ram"]
+	obj["disk"] = plan["disk"]
+	obj["vcpu_count"] = plan["vcpu_count"]
+}
+
+func (m *mockVultrAPI) registerBlockStorage() {
+	m.kind(&mockKind{
+		path: "/v2/blocks", single: "block", plural: "blocks", notFound: "Invalid block storage ID",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			obj["status"] = "active"
+			obj["cost"] = 1
+			obj["pending_charges"] = 0
+			obj["attached_to_instance"] = ""
+			obj["attached_to_instance_ip"] = ""
+			obj["attached_to_instance_label"] = ""
+			obj["mount_id"] = fmt.Sprintf("%s-%d", obj["region"], m.seq)
+			if obj["block_type"] == nil || obj["block_type"] == "" {
+				obj["block_type"] = "high_perf"
+			}
+			if obj["bootable"] == nil {
+				obj["bootable"] = false
+			}
+		},
+	})
+
+	m.action(http.MethodPost, "/v2/blocks/{}/attach", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		block, ok := m.get("/v2/blocks", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid block storage ID")
+			return
+		}
+		if block["attached_to_instance"] != "" {
+			mockError(w, http.StatusBadRequest, "Block storage is already attached to another server")
+			return
+		}
+
+		block["attached_to_instance"] = body["instance_id"]
+		if instance, ok := m.get("/v2/instances", fmt.Sprint(body["instance_id"])); ok {
+			block["attached_to_instance_ip"] = instance["main_ip"]
+			block["attached_to_instance_label"] = instance["label"]
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	m.action(http.MethodPost, "/v2/blocks/{}/detach", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		block, ok := m.get("/v2/blocks", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid block storage ID")
+			return
+		}
+
+		block["attached_to_instance"] = ""
+		block["attached_to_instance_ip"] = ""
+		block["attached_to_instance_label"] = ""
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (m *mockVultrAPI) registerDNS() {
+	m.kind(&mockKind{
+		path: "/v2/domains", single: "domain", plural: "domains", idKey: "domain", notFound: "Invalid domain.",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			if obj["dns_sec"] == nil || obj["dns_sec"] == "" {
+				obj["dns_sec"] = "disabled"
+			}
+
+			records := self + "/records"
+			m.put(records, m.newID(), map[string]interface{}{
+				"type": "SOA", "name": "", "data": "ns1.vultr.com dnsadm.vultr.com", "ttl": 300, "priority": -1,
+			})
+			for _, ns := range []string{"ns1.vultr.com", "ns2.vultr.com"} {
+				m.put(records, m.newID(), map[string]interface{}{"type": "NS", "name": "", "data": ns, "ttl": 300, "priority": -1})
+			}
+			if ip, ok := obj["ip"].(string); ok && ip != "" {
+				m.put(records, m.newID(), map[string]interface{}{"type": "A", "name": "", "data": ip, "ttl": 300, "priority": -1})
+			}
+			for _, id := range m.order[records] {
+				m.items[records][id]["id"] = id
+			}
+			delete(obj, "ip")
+		},
+	})
+
+	m.action(http.MethodGet, "/v2/domains/{}/soa", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		domain, ok := m.get("/v2/domains", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid domain.")
+			return
+		}
+		soa := map[string]interface{}{"nsprimary": "ns1.vultr.com", "email": "dnsadm@vultr.com"}
+		if domain["_soa"] != nil {
+			soa = domain["_soa"].(map[string]interface{})
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"dns_soa": soa})
+	})
+
+	m.action(http.MethodPatch, "/v2/domains/{}/soa", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		domain, ok := m.get("/v2/domains", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid domain.")
+			return
+		}
+		soa := map[string]interface{}{"nsprimary": "ns1.vultr.com", "email": "dnsadm@vultr.com"}
+		if domain["_soa"] != nil {
+			soa = domain["_soa"].(map[string]interface{})
+		}
+		for k, v := range body {
+			soa[k] = v
+		}
+		domain["_soa"] = soa
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	m.action(http.MethodGet, "/v2/domains/{}/dnssec", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		domain, ok := m.get("/v2/domains", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid domain.")
+			return
+		}
+		if domain["dns_sec"] != "enabled" {
+			mockError(w, http.StatusBadRequest, "DNSSEC is not enabled.")
+			return
+		}
+		name := params[0]
+		mockJSON(w, http.StatusOK, map[string]interface{}{"dns_sec": []string{
+			name + " IN DNSKEY 257 3 13 mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxIL",
+			name + " IN DS 2371 13 2 1F987CC6583E92DF0890718C42CD8E7EB7E6E6C9D0DD94CE5C35CF",
+		}})
+	})
+
+	m.kind(&mockKind{
+		path: "/v2/domains/{}/records", single: "record", plural: "records", notFound: "Invalid record.",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			if obj["priority"] == nil {
+				obj["priority"] = 0
+			}
+			if obj["ttl"] == nil {
+				obj["ttl"] = 300
+			}
+			mockQuoteTXT(obj)
+		},
+		update: func(m *mockVultrAPI, self string, obj, body map[string]interface{}) {
+			mockQuoteTXT(obj)
+		},
+	})
+}
+
+// mockQuoteTXT quotes the data of a TXT record the way the API stores it
+func mockQuoteTXT(obj map[string]interface{}) {
+	if data, ok := obj["data"].(string); ok && obj["type"] == "TXT" && !strings.HasPrefix(data, `"`) {
+		obj["data"] = `"` + data + `"`
+	}
+}
+
+func (m *mockVultrAPI) registerFirewalls() {
+	m.kind(&mockKind{
+		path: "/v2/firewalls", single: "firewall_group", plural: "firewall_groups", notFound: "Firewall group not found.",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			obj["date_modified"] = obj["date_created"]
+			obj["max_rule_count"] = 50
+		},
+		render: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			obj["rule_count"] = len(m.items[self+"/rules"])
+			obj["instance_count"] = 0
+			for _, instance := range m.list("/v2/instances") {
+				if self == "/v2/firewalls/"+fmt.Sprint(instance["firewall_group_id"]) {
+					obj["instance_count"] = obj["instance_count"].(int) + 1
+				}
+			}
+		},
+	})
+
+	m.kind(&mockKind{
+		path: "/v2/firewalls/{}/rules", single: "firewall_rule", plural: "firewall_rules", intID: true,
+		notFound: "Firewall rule ID not found.",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			obj["action"] = "accept"
+			obj["subnet"] = canonicalizeIP(obj["subnet"])
+			for _, key := range []string{"port", "source", "notes"} {
+				if obj[key] == nil {
+					obj[key] = ""
+				}
+			}
+		},
+	})
+}
+
+func (m *mockVultrAPI) registerVPCs() {
+	m.kind(&mockKind{
+		path: "/v2/vpcs", single: "vpc", plural: "vpcs", notFound: "Invalid VPC ID",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			if obj["v4_subnet"] == nil || obj["v4_subnet"] == "" {
+				obj["v4_subnet"] = fmt.Sprintf("10.%d.96.0", m.seq%250)
+				obj["v4_subnet_mask"] = 20
+			}
+			if obj["description"] == nil {
+				obj["description"] = ""
+			}
+		},
+	})
+}
+
+// registerAccountResources registers the plain collections that only need
+// CRUD to back their data sources
+func (m *mockVultrAPI) registerAccountResources() {
+	m.kind(&mockKind{path: "/v2/vpc2", single: "vpc", plural: "vpcs", notFound: "Invalid VPC ID"})
+	m.kind(&mockKind{
+		path: "/v2/reserved-ips", single: "reserved_ip", plural: "reserved_ips", notFound: "Invalid reserved IP",
+	})
+	m.kind(&mockKind{path: "/v2/snapshots", single: "snapshot", plural: "snapshots", notFound: "Invalid snapshot ID"})
+	m.kind(&mockKind{path: "/v2/ssh-keys", single: "ssh_key", plural: "ssh_keys", notFound: "Invalid SSH key ID"})
+}
+
+func (m *mockVultrAPI) registerLoadBalancers() {
+	m.kind(&mockKind{
+		path: "/v2/load-balancers", single: "load_balancer", plural: "load_balancers", notFound: "Invalid load balancer ID",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			n := m.seq
+			obj["status"] = "active"
+			obj["ipv4"] = fmt.Sprintf("198.51.100.%d", n%250+1)
+			obj["ipv6"] = fmt.Sprintf("2001:db8:2000::%x", n)
+			obj["has_ssl"] = obj["ssl"] != nil
+			if obj["nodes"] == nil {
+				obj["nodes"] = 1
+			}
+			if obj["health_check"] == nil {
+				obj["health_check"] = map[string]interface{}{
+					"protocol": "http", "port": 80, "path": "/", "check_interval": 15,
+					"response_timeout": 5, "unhealthy_threshold": 5, "healthy_threshold": 5,
+				}
+			}
+			obj["generic_info"] = map[string]interface{}{
+				"balancing_algorithm": "roundrobin",
+				"ssl_redirect":        false,
+				"proxy_protocol":      false,
+				"sticky_sessions":     map[string]interface{}{},
+			}
+			mockApplyLBGenericInfo(obj)
+			mockApplyLBRules(m, self, obj)
+		},
+		update: func(m *mockVultrAPI, self string, obj, body map[string]interface{}) {
+			if _, ok := body["ssl"]; ok {
+				obj["has_ssl"] = body["ssl"] != nil
+			}
+			mockApplyLBGenericInfo(obj)
+			mockApplyLBRules(m, self, obj)
+		},
+		render: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			rules := []interface{}{}
+			for _, rule := range m.list(self + "/forwarding-rules") {
+				rules = append(rules, rule)
+			}
+			obj["forwarding_rules"] = rules
+
+			fwRules := []interface{}{}
+			for _, rule := range m.list(self + "/firewall-rules") {
+				fwRules = append(fwRules, rule)
+			}
+			obj["firewall_rules"] = fwRules
+
+			if obj["auto_ssl"] == nil {
+				obj["auto_ssl"] = map[string]interface{}{"domain_zone": ""}
+			}
+		},
+	})
+
+	m.kind(&mockKind{
+		path: "/v2/load-balancers/{}/forwarding-rules", single: "forwarding_rule", plural: "forwarding_rules",
+		notFound: "Invalid forwarding rule ID",
+	})
+	m.kind(&mockKind{
+		path: "/v2/load-balancers/{}/firewall-rules", single: "firewall_rule", plural: "firewall_rules",
+		notFound: "Invalid firewall rule ID",
+	})
+
+	// firewall rules are created in bulk and the response has no body
+	m.action(http.MethodPost, "/v2/load-balancers/{}/firewall-rules", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		self := "/v2/load-balancers/" + params[0]
+		if _, ok := m.get("/v2/load-balancers", params[0]); !ok {
+			mockError(w, http.StatusNotFound, "Invalid load balancer ID")
+			return
+		}
+		rules, _ := body["firewall_rules"].([]interface{})
+		for _, r := range rules {
+			rule := r.(map[string]interface{})
+			id := m.newID()
+			rule["id"] = id
+			m.put(self+"/firewall-rules", id, rule)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	m.action(http.MethodDelete, "/v2/load-balancers/{}/ssl", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		lb, ok := m.get("/v2/load-balancers", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid load balancer ID")
+			return
+		}
+		delete(lb, "ssl")
+		lb["has_ssl"] = false
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	m.action(http.MethodDelete, "/v2/load-balancers/{}/auto_ssl", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		lb, ok := m.get("/v2/load-balancers", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid load balancer ID")
+			return
+		}
+		delete(lb, "auto_ssl")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// mockApplyLBGenericInfo moves the flat request fields of a load balancer
+// into the generic_info block the API returns them in.
+func mockApplyLBGenericInfo(obj map[string]interface{}) {
+	info := obj["generic_info"].(map[string]interface{})
+	for _, key := range []string{"balancing_algorithm", "ssl_redirect", "proxy_protocol", "vpc", "timeout"} {
+		if val, ok := obj[key]; ok {
+			if val != "" || key == "vpc" {
+				info[key] = val
+			}
+			delete(obj, key)
+		}
+	}
+
+	if sticky, ok := obj["sticky_session"]; ok {
+		info["sticky_sessions"] = sticky
+		delete(obj, "sticky_session")
+	}
+
+	if auto, ok := obj["auto_ssl"].(map[string]interface{}); ok && auto["domain"] == nil {
+		domain := fmt.Sprint(auto["domain_zone"])
+		if sub, ok := auto["domain_sub"].(string); ok && sub != "" {
+			domain = sub + "." + domain
+		}
+		auto["domain"] = domain
+	}
+}
+
+// mockApplyLBRules replaces the nested rule collections of a load balancer
+// when a create or update request carries a full rule list.
+func mockApplyLBRules(m *mockVultrAPI, self string, obj map[string]interface{}) {
+	for _, key := range []string{"forwarding_rules", "firewall_rules"} {
+		rules, ok := obj[key].([]interface{})
+		delete(obj, key)
+		if !ok {
+			continue
+		}
+
+		collection := self + "/" + strings.ReplaceAll(key, "_", "-")
+		delete(m.items, collection)
+		delete(m.order, collection)
+		for _, r := range rules {
+			rule := r.(map[string]interface{})
+			id := m.newID()
+			rule["id"] = id
+			m.put(collection, id, rule)
+		}
+	}
+}
+
+func (m *mockVultrAPI) registerDatabases() {
+	m.kind(&mockKind{
+		path: "/v2/databases", single: "database", plural: "databases", notFound: "invalid database ID",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			n := m.seq
+			obj["status"] = "Running"
+			obj["plan_disk"] = 55
+			obj["plan_ram"] = 3840
+			obj["plan_vcpus"] = 1
+			obj["plan_replicas"] = 0
+			obj["pending_charges"] = 0
+			obj["dbname"] = "defaultdb"
+			obj["host"] = fmt.Sprintf("vultr-prod-%d.vultrdb.com", n)
+			obj["port"] = "16751"
+			obj["user"] = "vultradmin"
+			obj["password"] = "mock-db-password"
+			obj["latest_backup"] = mockTimestamp()
+			obj["ca_certificate"] = "-----BEGIN CERTIFICATE-----\nMOCK\n-----END CERTIFICATE-----\n"
+			for key, def := range map[string]interface{}{
+				"tag": "", "vpc_id": "", "maintenance_dow": "sunday", "maintenance_time": "06:00",
+				"backup_hour": "0", "backup_minute": "0", "cluster_time_zone": "UTC",
+			} {
+				if v, ok := obj[key]; !ok || v == "" {
+					obj[key] = def
+				}
+			}
+			if obj["trusted_ips"] == nil {
+				obj["trusted_ips"] = []interface{}{}
+			}
+
+			m.put(self+"/users", "vultradmin", map[string]interface{}{
+				"username": "vultradmin", "password": obj["password"], "encryption": "",
+			})
+		},
+		update: func(m *mockVultrAPI, self string, obj, body map[string]interface{}) {
+			for _, key := range []string{"backup_hour", "backup_minute"} {
+				if body[key] == "" {
+					obj[key] = "0"
+				}
+			}
+		},
+		// a failed upgrade is rebuilding once and then runs the old version
+		// again, an upgrade with a transient error is upgraded after it
+		render: func(m *mockVultrAPI, self string, _ map[string]interface{}) {
+			obj, ok := m.get("/v2/databases", strings.TrimPrefix(self, "/v2/databases/"))
+			switch {
+			case !ok:
+			case obj["status"] == "Rebuilding":
+				obj["status"] = "Running"
+			case obj["status"] == "Error" && obj["_upgrade_version"] != nil:
+				obj["status"] = "Running"
+				obj["database_engine_version"] = obj["_upgrade_version"]
+				delete(obj, "_upgrade_version")
+			}
+		},
+	})
+
+	m.kind(&mockKind{
+		path: "/v2/databases/{}/users", single: "user", plural: "users", idKey: "username", notFound: "invalid user",
+		update: func(m *mockVultrAPI, self string, obj, body map[string]interface{}) {
+			if password, ok := body["password"]; ok && strings.HasSuffix(self, "/users/vultradmin") {
+				if db, ok := m.get("/v2/databases", strings.Split(self, "/")[3]); ok {
+					db["password"] = password
+				}
+			}
+		},
+	})
+
+	m.kind(&mockKind{path: "/v2/databases/{}/dbs", single: "db", plural: "dbs", idKey: "name", notFound: "invalid db"})
+
+	m.action(http.MethodGet, "/v2/databases/plans", func(_ *mockVultrAPI, w http.ResponseWriter, _ *http.Request, _ []string, _ map[string]interface{}) { //nolint:lll
+		plans := []interface{}{
+			map[string]interface{}{
+				"id": "vultr-dbaas-hobbyist-cc-1-25-1", "number_of_nodes": 1, "type": "hobbyist", "vcpu_count": 1,
+				"ram": 1024, "disk": 25, "monthly_cost": 15, "locations": []interface{}{"ewr", "ams"},
+				"supported_engines": map[string]interface{}{"mysql": true, "pg": true, "valkey": true, "kafka": false},
+			},
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"plans": plans, "meta": mockMeta(len(plans))})
+	})
+
+	m.action(http.MethodGet, "/v2/databases/{}/backups", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		if _, ok := m.get("/v2/databases", params[0]); !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+
+		now := time.Now().UTC()
+		backup := func(at time.Time) map[string]interface{} {
+			return map[string]interface{}{"date": at.Format("2006-01-02"), "time": at.Format("15:04:05")}
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{
+			"latest_backup": backup(now.Add(-time.Hour)),
+			"oldest_backup": backup(now.Add(-7 * 24 * time.Hour)),
+		})
+	})
+
+	// a fork keeps the engine of its source and records the request for tests
+	m.action(http.MethodPost, "/v2/databases/{}/fork", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		source, ok := m.get("/v2/databases", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+
+		k := m.kindFor("/v2/databases")
+		obj := m.insert(k, "/v2/databases", map[string]interface{}{
+			"database_engine":         source["database_engine"],
+			"database_engine_version": source["database_engine_version"],
+			"region":                  body["region"],
+			"plan":                    body["plan"],
+			"label":                   body["label"],
+			"_fork":                   body,
+		})
+		mockJSON(w, http.StatusAccepted, map[string]interface{}{"database": m.render(k, "/v2/databases", obj)})
+	})
+
+	advancedOptions := map[string][]interface{}{
+		"pg": {
+			map[string]interface{}{"name": "autovacuum_analyze_scale_factor", "type": "float", "min_value": 0, "max_value": 1},
+			map[string]interface{}{"name": "max_worker_processes", "type": "int", "min_value": 8, "max_value": 96},
+			map[string]interface{}{"name": "jit", "type": "bool"},
+			map[string]interface{}{
+				"name": "log_error_verbosity", "type": "enum", "enumerals": []interface{}{"TERSE", "DEFAULT", "VERBOSE"},
+			},
+			map[string]interface{}{
+				"name": "temp_file_limit", "type": "int", "min_value": 0, "max_value": 2147483647,
+				"alt_values": []interface{}{-1},
+			},
+		},
+		"mysql": {
+			map[string]interface{}{"name": "innodb_read_io_threads", "type": "int", "min_value": 1, "max_value": 64},
+			map[string]interface{}{"name": "wait_timeout", "type": "int", "min_value": 1, "max_value": 2147483},
+		},
+	}
+
+	advancedOptionsResponse := func(db map[string]interface{}) map[string]interface{} {
+		if db["_advanced_options"] == nil {
+			db["_advanced_options"] = map[string]interface{}{}
+		}
+		return map[string]interface{}{
+			"configured_options": db["_advanced_options"],
+			"available_options":  advancedOptions[fmt.Sprint(db["database_engine"])],
+		}
+	}
+
+	m.action(http.MethodGet, "/v2/databases/{}/advanced-options", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+		mockJSON(w, http.StatusOK, advancedOptionsResponse(db))
+	})
+
+	m.action(http.MethodPut, "/v2/databases/{}/advanced-options", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+
+		response := advancedOptionsResponse(db)
+		for name, value := range body {
+			known := false
+			for _, option := range advancedOptions[fmt.Sprint(db["database_engine"])] {
+				known = known || option.(map[string]interface{})["name"] == name
+			}
+			if !known {
+				mockError(w, http.StatusBadRequest, fmt.Sprintf("invalid option %s", name))
+				return
+			}
+			if value == nil {
+				delete(db["_advanced_options"].(map[string]interface{}), name)
+				continue
+			}
+			db["_advanced_options"].(map[string]interface{})[name] = value
+		}
+		mockJSON(w, http.StatusOK, response)
+	})
+
+	// a migration reports running once and then finishes, or fails when the
+	// source host starts with "unreachable"
+	m.action(http.MethodPost, "/v2/databases/{}/migration", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+
+		credentials := map[string]interface{}{}
+		for k, v := range body {
+			if k != "password" {
+				credentials[k] = v
+			}
+		}
+		db["_migration"] = map[string]interface{}{"status": "running", "method": "replication", "credentials": credentials}
+		mockJSON(w, http.StatusAccepted, map[string]interface{}{"migration": db["_migration"]})
+	})
+
+	m.action(http.MethodGet, "/v2/databases/{}/migration", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok || db["_migration"] == nil {
+			mockError(w, http.StatusNotFound, "no migration found")
+			return
+		}
+
+		migration := db["_migration"].(map[string]interface{})
+		response := map[string]interface{}{}
+		for k, v := range migration {
+			response[k] = v
+		}
+		if migration["status"] == "running" {
+			migration["status"] = "done"
+			host := fmt.Sprint(migration["credentials"].(map[string]interface{})["host"])
+			if strings.HasPrefix(host, "unreachable") {
+				migration["status"] = "failed"
+				migration["error"] = "could not connect to " + host
+			}
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"migration": response})
+	})
+
+	m.action(http.MethodDelete, "/v2/databases/{}/migration", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok || db["_migration"] == nil {
+			mockError(w, http.StatusNotFound, "no migration found")
+			return
+		}
+
+		delete(db, "_migration")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	m.action(http.MethodGet, "/v2/databases/{}/version-upgrade", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+
+		versions := []interface{}{}
+		if current, err := strconv.Atoi(fmt.Sprint(db["database_engine_version"])); err == nil {
+			versions = append(versions, strconv.Itoa(current+1))
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"available_versions": versions})
+	})
+
+	m.action(http.MethodPost, "/v2/databases/{}/version-upgrade", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		db, ok := m.get("/v2/databases", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+
+		switch {
+		case db["_upgrade_fails"] == true:
+			db["status"] = "Rebuilding"
+		case db["_upgrade_errors"] == true:
+			db["status"] = "Error"
+			db["_upgrade_version"] = body["version"]
+		default:
+			db["database_engine_version"] = body["version"]
+		}
+		mockJSON(w, http.StatusOK, map[string]interface{}{"message": "Version upgrade started."})
+	})
+}
+
+func (m *mockVultrAPI) registerKubernetes() {
+	m.kind(&mockKind{
+		path: "/v2/kubernetes/clusters", single: "vke_cluster", plural: "vke_clusters", notFound: "Invalid resource ID",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			n := m.seq
+			obj["status"] = "active"
+			obj["ip"] = fmt.Sprintf("203.0.113.%d", n%250+1)
+			obj["endpoint"] = fmt.Sprintf("%s.vultr-k8s.com", strings.TrimPrefix(self, "/v2/kubernetes/clusters/"))
+			obj["cluster_subnet"] = "10.244.0.0/16"
+			obj["service_subnet"] = "10.96.0.0/12"
+			obj["firewall_group_id"] = ""
+			if obj["oidc"] == nil {
+				obj["oidc"] = map[string]interface{}{}
+			}
+
+			pools, _ := obj["node_pools"].([]interface{})
+			delete(obj, "node_pools")
+			for _, p := range pools {
+				m.insert(m.kindFor("/v2/kubernetes/clusters/{}/node-pools"), self+"/node-pools", p.(map[string]interface{}))
+			}
+		},
+		render: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			pools := []interface{}{}
+			for _, pool := range m.list(self + "/node-pools") {
+				pools = append(pools, pool)
+			}
+			obj["node_pools"] = pools
+		},
+	})
+
+	m.kind(&mockKind{
+		path: "/v2/kubernetes/clusters/{}/node-pools", single: "node_pool", plural: "node_pools",
+		notFound: "Invalid NodePool ID",
+		create: func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+			obj["status"] = "active"
+			obj["date_updated"] = obj["date_created"]
+			for key, def := range map[string]interface{}{
+				"tag": "", "user_data": "", "min_nodes": 0, "max_nodes": 0, "auto_scaler": false,
+			} {
+				if obj[key] == nil {
+					obj[key] = def
+				}
+			}
+			mockScaleNodePool(m, obj)
+		},
+		update: func(m *mockVultrAPI, self string, obj, body map[string]interface{}) {
+			obj["date_updated"] = mockTimestamp()
+			mockScaleNodePool(m, obj)
+		},
+	})
+
+	m.kind(&mockKind{
+		path: "/v2/kubernetes/clusters/{}/node-pools/{}/labels", single: "label", plural: "labels",
+		notFound: "Invalid label ID",
+	})
+	m.kind(&mockKind{
+		path: "/v2/kubernetes/clusters/{}/node-pools/{}/taints", single: "taint", plural: "taints",
+		notFound: "Invalid taint ID",
+	})
+
+	m.action(http.MethodGet, "/v2/kubernetes/clusters/{}/config", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		cluster, ok := m.get("/v2/kubernetes/clusters", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid resource ID")
+			return
+		}
+
+		kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: vke
+  cluster:
+    certificate-authority-data: %s
+    server: https://%s:6443
+users:
+- name: admin
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`,
+			base64.StdEncoding.EncodeToString([]byte("mock-ca")),
+			cluster["endpoint"],
+			base64.StdEncoding.EncodeToString([]byte("mock-cert")),
+			base64.StdEncoding.EncodeToString([]byte("mock-key")),
+		)
+		encoded := base64.StdEncoding.EncodeToString([]byte(kubeconfig))
+		mockJSON(w, http.StatusOK, map[string]interface{}{"kube_config": encoded})
+	})
+
+	m.action(http.MethodGet, "/v2/kubernetes/clusters/{}/available-upgrades", func(_ *mockVultrAPI, w http.ResponseWriter, _ *http.Request, _ []string, _ map[string]interface{}) { //nolint:lll
+		mockJSON(w, http.StatusOK, map[string]interface{}{"available_upgrades": []interface{}{}})
+	})
+
+	m.action(http.MethodPost, "/v2/kubernetes/clusters/{}/upgrades", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, body map[string]interface{}) { //nolint:lll
+		cluster, ok := m.get("/v2/kubernetes/clusters", params[0])
+		if !ok {
+			mockError(w, http.StatusNotFound, "Invalid resource ID")
+			return
+		}
+		cluster["version"] = body["upgrade_version"]
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	m.action(http.MethodDelete, "/v2/kubernetes/clusters/{}/delete-with-linked-resources", func(m *mockVultrAPI, w http.ResponseWriter, _ *http.Request, params []string, _ map[string]interface{}) { //nolint:lll
+		m.remove("/v2/kubernetes/clusters", params[0])
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (m *mockVultrAPI) registerCDN() {
+	create := func(m *mockVultrAPI, self string, obj map[string]interface{}) {
+		obj["status"] = "active"
+		obj["cdn_url"] = fmt.Sprintf("%s.vultrcdn.com", obj["id"])
+		if obj["regions"] == nil {
+			obj["regions"] = []interface{}{"ewr", "ams"}
+		}
+	}
+
+	m.kind(&mockKind{
+		path: "/v2/cdns/pull-zones", single: "pull_zone", plural: "pull_zones", notFound: "Invalid pull zone ID",
+		create: create,
+	})
+	m.kind(&mockKind{
+		path: "/v2/cdns/push-zones", single: "push_zone", plural: "push_zones", notFound: "Invalid push zone ID",
+		create: create,
+	})
+
+	m.action(http.MethodGet, "/v2/cdns/push-zones/{}/files",
+		func(m *mockVultrAPI, w http.ResponseWriter, r *http.Request, params []string, body map[string]interface{}) {
+			files := []interface{}{}
+			size := 0
+			for _, f := range m.list("/v2/cdns/push-zones/" + params[0] + "/files") {
+				n, _ := strconv.Atoi(fmt.Sprint(f["size"]))
+				size += n
+				files = append(files, f)
+			}
+			mockJSON(w, http.StatusOK, map[string]interface{}{"files": files, "count": len(files), "total_size": size})
+		})
+	m.action(http.MethodPost, "/v2/cdns/push-zones/{}/files",
+		func(m *mockVultrAPI, w http.ResponseWriter, r *http.Request, params []string, body map[string]interface{}) {
+			if _, ok := m.get("/v2/cdns/push-zones", params[0]); !ok {
+				mockError(w, http.StatusNotFound, "Invalid push zone ID")
+				return
+			}
+			mockJSON(w, http.StatusCreated, map[string]interface{}{"upload_endpoint": map[string]interface{}{
+				"url": fmt.Sprintf("https://%s.vultrcdn.com/upload", params[0]),
+				"inputs": map[string]interface{}{
+					"acl": "public-read", "key": body["name"], "policy": "mock-policy",
+					"x-amz-credential": "mock-credential", "x-amz-algorithm": "AWS4-HMAC-SHA256",
+					"x-amz-signature": "mock-signature",
+				},
+			}})
+		})
+}
+
+func (m *mockVultrAPI) kindFor(path string) *mockKind {
+	for _, k := range m.kinds {
+		if k.path == path {
+			return k
+		}
+	}
+	panic("unknown mock kind " + path)
+}
+
+// mockScaleNodePool keeps the nodes of a node pool in line with its quantity
+func mockScaleNodePool(m *mockVultrAPI, pool map[string]interface{}) {
+	quantity, _ := strconv.Atoi(fmt.Sprint(pool["node_quantity"]))
+	nodes, _ := pool["nodes"].([]interface{})
+
+	for len(nodes) < quantity {
+		id := m.newID()
+		nodes = append(nodes, map[string]interface{}{
+			"id":           id,
+			"label":        fmt.Sprintf("%s-%s", pool["label"], id[:8]),
+			"ip":           fmt.Sprintf("10.8.0.%d", m.seq%250+1),
+			"status":       "active",
+			"date_created": mockTimestamp(),
+		})
+	}
+
+	if quantity >= 0 && len(nodes) > quantity {
+		nodes = nodes[:quantity]
+	}
+
+	pool["nodes"] = nodes
+}
+
+func splitMockPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func matchMockPath(pattern, segs []string) ([]string, bool) {
+	if len(pattern) != len(segs) {
+		return nil, false
+	}
+
+	var params []string
+	for i := range pattern {
+		if pattern[i] == "{}" {
+			params = append(params, segs[i])
+			continue
+		}
+		if pattern[i] != segs[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func mockJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func mockError(w http.ResponseWriter, status int, msg string) {
+	mockJSON(w, status, map[string]interface{}{"error": msg, "status": status})
+}
+
+func mockMeta(total int) map[string]interface{} {
+	return map[string]interface{}{"total": total, "links": map[string]interface{}{"next": "", "prev": ""}}
+}
+
+func mockTimestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func mockStringList(v interface{}) []string {
+	var out []string
+	switch list := v.(type) {
+	case []string:
+		out = append(out, list...)
+	case []interface{}:
+		for _, item := range list {
+			out = append(out, fmt.Sprint(item))
+		}
+	}
+	return out
+}
+
+func mockWithout(list, remove []string) []string {
+	drop := map[string]bool{}
+	for _, v := range remove {
+		drop[v] = true
+	}
+
+	seen := map[string]bool{}
+	var out []string
+	for _, v := range list {
+		if !drop[v] && !seen[v] {
+			out = append(out, v)
+			seen[v] = true
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,1923 @@
+package vultr
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testMockPreCheck skips resource.UnitTest cases when no terraform binary is
+// available, since the plugin test harness cannot run without one.
+func testMockPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run mock unit tests")
+	}
+}
+
+// mockLifecycle drives a single resource through plan, apply, refresh,
+// import and destroy against the fake API without the terraform CLI.
+type mockLifecycle struct {
+	t     *testing.T
+	res   *schema.Resource
+	meta  interface{}
+	state *terraform.InstanceState
+}
+
+func newMockLifecycle(t *testing.T, api *mockVultrAPI, name string) *mockLifecycle {
+	t.Helper()
+
+	res, ok := Provider().ResourcesMap[name]
+	if !ok {
+		t.Fatalf("unknown resource %s", name)
+	}
+
+	return &mockLifecycle{t: t, res: res, meta: api.client(t)}
+}
+
+// withRawConfig hands raw to the next plan as the raw configuration, which
+// only Terraform itself otherwise provides. Attributes left out of raw are
+// null, as they are for a configuration that doesn't set them.
+func (l *mockLifecycle) withRawConfig(raw map[string]interface{}) *mockLifecycle {
+	l.t.Helper()
+
+	if l.state == nil {
+		l.state = &terraform.InstanceState{}
+	}
+	l.state.RawConfig = l.ctyValue(raw)
+
+	return l
+}
+
+// withRawState hands raw to the next plan as the raw prior state, which
+// Terraform keeps even when the SDK plans a replacement without the state
+func (l *mockLifecycle) withRawState(raw map[string]interface{}) *mockLifecycle {
+	l.t.Helper()
+
+	if l.state == nil {
+		l.state = &terraform.InstanceState{}
+	}
+	l.state.RawState = l.ctyValue(raw)
+
+	return l
+}
+
+func (l *mockLifecycle) ctyValue(raw map[string]interface{}) cty.Value {
+	l.t.Helper()
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		l.t.Fatal(err)
+	}
+	v, err := ctyjson.Unmarshal(b, l.res.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		l.t.Fatalf("error converting %v to a cty value: %v", raw, err)
+	}
+
+	return v
+}
+
+// apply plans the given configuration against the current state and applies
+// the resulting diff, failing the test on any error.
+func (l *mockLifecycle) apply(raw map[string]interface{}) *mockLifecycle {
+	l.t.Helper()
+
+	ctx := context.Background()
+	diff, err := l.res.Diff(ctx, l.state, terraform.NewResourceConfigRaw(raw), l.meta)
+	if err != nil {
+		l.t.Fatalf("error planning: %v", err)
+	}
+	if diff == nil || diff.Empty() {
+		return l
+	}
+
+	state, diags := l.res.Apply(ctx, l.state, diff, l.meta)
+	if diags.HasError() {
+		l.t.Fatalf("error applying: %v", diags)
+	}
+	l.state = state
+
+	return l
+}
+
+// applyError plans and applies the configuration like apply, expecting it to
+// fail with an error containing msg. The state is left as it was.
+func (l *mockLifecycle) applyError(raw map[string]interface{}, msg string) *mockLifecycle {
+	l.t.Helper()
+
+	ctx := context.Background()
+	diff, err := l.res.Diff(ctx, l.state, terraform.NewResourceConfigRaw(raw), l.meta)
+	if err == nil && diff != nil && !diff.Empty() {
+		var diags diag.Diagnostics
+		_, diags = l.res.Apply(ctx, l.state, diff, l.meta)
+		if diags.HasError() {
+			err = fmt.Errorf("%v", diags)
+		}
+	}
+	if err == nil || !strings.Contains(err.Error(), msg) {
+		l.t.Fatalf("expected an error containing %q, got %v", msg, err)
+	}
+
+	return l
+}
+
+// planEmpty asserts that the configuration produces no changes
+func (l *mockLifecycle) planEmpty(raw map[string]interface{}) *mockLifecycle {
+	l.t.Helper()
+
+	diff, err := l.res.Diff(context.Background(), l.state, terraform.NewResourceConfigRaw(raw), l.meta)
+	if err != nil {
+		l.t.Fatalf("error planning: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		l.t.Fatalf("expected empty plan, got: %s", diff.GoString())
+	}
+
+	return l
+}
+
+func (l *mockLifecycle) refresh() *mockLifecycle {
+	l.t.Helper()
+
+	state, diags := l.res.RefreshWithoutUpgrade(context.Background(), l.state, l.meta)
+	if diags.HasError() {
+		l.t.Fatalf("error refreshing: %v", diags)
+	}
+	l.state = state
+
+	return l
+}
+
+// importVerify imports the resource by id and checks the imported state
+// matches the applied state, apart from the ignored attributes.
+func (l *mockLifecycle) importVerify(id string, ignore ...string) *mockLifecycle {
+	l.t.Helper()
+
+	if id == "" {
+		id = l.state.ID
+	}
+
+	ctx := context.Background()
+	data := l.res.Data(&terraform.InstanceState{ID: id})
+	var imported []*schema.ResourceData
+	var err error
+	if l.res.Importer.StateContext != nil {
+		imported, err = l.res.Importer.StateContext(ctx, data, l.meta)
+	} else {
+		imported, err = l.res.Importer.State(data, l.meta) //nolint:staticcheck
+	}
+	if err != nil {
+		l.t.Fatalf("error importing %s: %v", id, err)
+	}
+	if len(imported) != 1 {
+		l.t.Fatalf("expected one imported resource, got %d", len(imported))
+	}
+
+	state, diags := l.res.RefreshWithoutUpgrade(ctx, imported[0].State(), l.meta)
+	if diags.HasError() {
+		l.t.Fatalf("error reading imported %s: %v", id, diags)
+	}
+
+	for k, v := range l.state.Attributes {
+		if mockIgnored(k, ignore) {
+			continue
+		}
+		if got, ok := state.Attributes[k]; !ok && v == "0" && (strings.HasSuffix(k, ".#") || strings.HasSuffix(k, ".%")) {
+			continue
+		} else if got != v {
+			l.t.Errorf("imported attribute %s = %q, expected %q", k, got, v)
+		}
+	}
+
+	return l
+}
+
+func (l *mockLifecycle) destroy() {
+	l.t.Helper()
+
+	_, diags := l.res.Apply(context.Background(), l.state, &terraform.InstanceDiff{Destroy: true}, l.meta)
+	if diags.HasError() {
+		l.t.Fatalf("error destroying: %v", diags)
+	}
+	l.state = nil
+}
+
+// gone asserts that a refresh removes the resource from state
+func (l *mockLifecycle) gone() {
+	l.t.Helper()
+
+	state, diags := l.res.RefreshWithoutUpgrade(context.Background(), l.state, l.meta)
+	if diags.HasError() {
+		l.t.Fatalf("error refreshing: %v", diags)
+	}
+	if state != nil && state.ID != "" {
+		l.t.Fatalf("expected resource to be removed from state, still have %s", state.ID)
+	}
+}
+
+func (l *mockLifecycle) check(attrs map[string]string) *mockLifecycle {
+	l.t.Helper()
+
+	for k, v := range attrs {
+		if got := l.state.Attributes[k]; got != v {
+			l.t.Errorf("attribute %s = %q, expected %q", k, got, v)
+		}
+	}
+
+	return l
+}
+
+func mockIgnored(key string, ignore []string) bool {
+	for _, prefix := range ignore {
+		if key == prefix || strings.HasPrefix(key, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMockVultrInstanceLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"plan":     "vc2-1c-1gb",
+		"region":   "ewr",
+		"os_id":    1743,
+		"label":    "mock-instance",
+		"hostname": "mock-instance",
+		"tags":     []interface{}{"one", "two"},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_instance").
+		apply(config).
+		check(map[string]string{
+			"label":        "mock-instance",
+			"os":           "Ubuntu 22.04 LTS x64",
+			"ram":          "1024",
+			"disk":         "25",
+			"status":       "active",
+			"power_status": "running",
+			"tags.#":       "2",
+		}).
+		refresh().
+		planEmpty(config)
+
+	config["label"] = "mock-instance-updated"
+	config["plan"] = "vc2-2c-4gb"
+	config["tags"] = []interface{}{"one", "three"}
+	l.apply(config).
+		check(map[string]string{
+			"label":  "mock-instance-updated",
+			"plan":   "vc2-2c-4gb",
+			"ram":    "4096",
+			"tags.#": "2",
+		}).
+		importVerify("", "default_password", "user_data", "activation_email", "ddos_protection", "enable_ipv6").
+		destroy()
+
+	if n := api.count("/v2/instances"); n != 0 {
+		t.Fatalf("expected instance to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrInstanceDefaultTags(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"plan":   "vc2-1c-1gb",
+		"region": "ewr",
+		"os_id":  1743,
+		"tags":   []interface{}{"web"},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_instance")
+	l.meta.(*Client).defaultTags = []string{"team:infra"}
+	l.apply(config).
+		check(map[string]string{"tags.#": "1", "tags_all.#": "2"}).
+		planEmpty(config)
+
+	// a new default tag is planned for the existing instance and sent on apply
+	l.meta.(*Client).defaultTags = []string{"cost:42", "team:infra"}
+	l.apply(config).
+		check(map[string]string{"tags.#": "1", "tags_all.#": "3"}).
+		planEmpty(config)
+
+	instance, _ := api.get("/v2/instances", l.state.ID)
+	if tags := instance["tags"].([]interface{}); len(tags) != 3 {
+		t.Fatalf("expected the new default tag to reach the API, got %v", tags)
+	}
+
+	l.destroy()
+}
+
+func TestMockVultrDatabaseDefaultTags(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"database_engine":         "pg",
+		"database_engine_version": "15",
+		"region":                  "ewr",
+		"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+		"label":                   "mock-db-tags",
+		"backup_hour":             "3",
+		"backup_minute":           "30",
+	}
+
+	// a single tag can't hold the default tags, so none are sent
+	l := newMockLifecycle(t, api, "vultr_database")
+	l.meta.(*Client).defaultTags = []string{"cost:42", "team:infra"}
+	l.apply(config).
+		check(map[string]string{"tag": ""}).
+		planEmpty(config)
+
+	database, _ := api.get("/v2/databases", l.state.ID)
+	if tag := database["tag"]; tag != nil && tag != "" {
+		t.Fatalf("expected no default tag to reach the API, got %v", tag)
+	}
+}
+
+func TestMockVultrBlockStorageLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"region":  "ewr",
+		"size_gb": 10,
+		"label":   "mock-block",
+	}
+
+	l := newMockLifecycle(t, api, "vultr_block_storage").
+		apply(config).
+		check(map[string]string{"size_gb": "10", "status": "active", "block_type": "high_perf"}).
+		planEmpty(config)
+
+	config["size_gb"] = 20
+	config["label"] = "mock-block-resized"
+	l.apply(config).
+		check(map[string]string{"size_gb": "20", "label": "mock-block-resized"}).
+		importVerify("", "live").
+		destroy()
+
+	l.state = &terraform.InstanceState{ID: "missing"}
+	l.gone()
+}
+
+func TestMockVultrDNSLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	domain := newMockLifecycle(t, api, "vultr_dns_domain").
+		apply(map[string]interface{}{"domain": "mock.example.com", "ip": "192.0.2.10"}).
+		check(map[string]string{"domain": "mock.example.com", "dns_sec": "disabled"}).
+		importVerify("", "ip")
+
+	record := newMockLifecycle(t, api, "vultr_dns_record").
+		apply(map[string]interface{}{
+			"domain": "mock.example.com",
+			"name":   "www",
+			"type":   "CNAME",
+			"data":   "mock.example.com",
+			"ttl":    600,
+		}).
+		check(map[string]string{"name": "www", "type": "CNAME", "ttl": "600"}).
+		refresh()
+	record.importVerify(fmt.Sprintf("mock.example.com,%s", record.state.ID))
+
+	record.destroy()
+	domain.destroy()
+
+	if n := api.count("/v2/domains"); n != 0 {
+		t.Fatalf("expected domain to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrDNSDomainSecLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"domain": "sec.example.com",
+		"soa":    []interface{}{map[string]interface{}{"email": "hostmaster@example.com"}},
+	}
+	l := newMockLifecycle(t, api, "vultr_dns_domain").
+		apply(config).
+		check(map[string]string{
+			"dns_sec":                 "disabled",
+			"soa.0.nsprimary":         "ns1.vultr.com",
+			"soa.0.email":             "hostmaster@example.com",
+			"dnssec_ds_records.#":     "0",
+			"dnssec_dnskey_records.#": "0",
+		}).
+		planEmpty(config)
+
+	config["dns_sec"] = "enabled"
+	config["soa"] = []interface{}{
+		map[string]interface{}{"nsprimary": "ns1.example.com", "email": "hostmaster@example.com"},
+	}
+	l.apply(config).
+		check(map[string]string{
+			"dns_sec":                 "enabled",
+			"soa.0.nsprimary":         "ns1.example.com",
+			"dnssec_ds_records.#":     "1",
+			"dnssec_dnskey_records.#": "1",
+			"dnssec_ds_records.0":     "sec.example.com IN DS 2371 13 2 1F987CC6583E92DF0890718C42CD8E7EB7E6E6C9D0DD94CE5C35CF",
+		}).
+		planEmpty(config).
+		importVerify("")
+
+	ds := dataSourceVultrDNSDomain()
+	d := ds.TestResourceData()
+	if err := d.Set("domain", "sec.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if diags := ds.ReadContext(context.Background(), d, api.client(t)); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	if got := d.Get("dnssec_dnskey_records").([]interface{}); len(got) != 1 {
+		t.Fatalf("expected 1 DNSKEY record from the data source, got %v", got)
+	}
+	if got := d.Get("soa.0.email"); got != "hostmaster@example.com" {
+		t.Fatalf("expected the data source SOA email to be hostmaster@example.com, got %v", got)
+	}
+
+	config["dns_sec"] = "disabled"
+	l.apply(config).
+		check(map[string]string{"dnssec_ds_records.#": "0", "dnssec_dnskey_records.#": "0"}).
+		destroy()
+}
+
+func TestMockVultrDNSZoneRecordsLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	newMockLifecycle(t, api, "vultr_dns_domain").
+		apply(map[string]interface{}{"domain": "zone.example.com", "ip": "192.0.2.10"})
+	records := "/v2/domains/zone.example.com/records"
+
+	config := map[string]interface{}{
+		"domain": "zone.example.com",
+		"record": []interface{}{
+			map[string]interface{}{"type": "A", "data": "192.0.2.10", "ttl": 300},
+			map[string]interface{}{"name": "www", "type": "CNAME", "data": "zone.example.com"},
+			map[string]interface{}{"type": "MX", "data": "mail.example.com", "priority": 10},
+		},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_dns_zone_records").
+		apply(config).
+		check(map[string]string{"record.#": "3"}).
+		planEmpty(config)
+
+	// the SOA and NS records vultr manages stay out of the record set
+	if n := api.count(records); n != 6 {
+		t.Fatalf("expected 6 records on the domain, got %d", n)
+	}
+
+	// a record added out of band is drift
+	api.put(records, "extra", map[string]interface{}{
+		"id": "extra", "type": "TXT", "name": "", "data": "stray", "ttl": 300,
+	})
+	l.refresh().check(map[string]string{"record.#": "4"})
+
+	before := api.requestCount("POST", "/v2/domains/zone.example.com/records")
+	config["record"] = []interface{}{
+		map[string]interface{}{"type": "A", "data": "192.0.2.20", "ttl": 300},
+		map[string]interface{}{"name": "www", "type": "CNAME", "data": "zone.example.com"},
+		map[string]interface{}{"type": "MX", "data": "mail.example.com", "priority": 10},
+	}
+	l.apply(config).
+		check(map[string]string{"record.#": "3"}).
+		planEmpty(config)
+
+	if _, ok := api.get(records, "extra"); ok {
+		t.Fatalf("expected the out of band record to be deleted")
+	}
+	if n := api.requestCount("POST", "/v2/domains/zone.example.com/records") - before; n != 0 {
+		t.Fatalf("expected the A record to be updated in place, got %d creates", n)
+	}
+
+	l.importVerify("zone.example.com").destroy()
+
+	// only the SOA and NS records are left
+	if n := api.count(records); n != 3 {
+		t.Fatalf("expected 3 records on the domain, got %d", n)
+	}
+}
+
+func TestMockVultrDNSZoneRecordsManagedLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	newMockLifecycle(t, api, "vultr_dns_domain").
+		apply(map[string]interface{}{"domain": "owned.example.com"})
+
+	config := map[string]interface{}{
+		"domain":               "owned.example.com",
+		"keep_managed_records": false,
+		"record": []interface{}{
+			map[string]interface{}{"type": "NS", "data": "ns1.vultr.com", "ttl": 300},
+			map[string]interface{}{"type": "NS", "data": "ns2.vultr.com", "ttl": 300},
+			map[string]interface{}{"type": "A", "data": "192.0.2.10", "ttl": 300},
+		},
+	}
+
+	// the SOA is never part of the record set, so it is neither drift nor
+	// deleted
+	newMockLifecycle(t, api, "vultr_dns_zone_records").
+		apply(config).
+		check(map[string]string{"record.#": "3"}).
+		planEmpty(config).
+		refresh().
+		planEmpty(config)
+
+	if n := api.count("/v2/domains/owned.example.com/records"); n != 4 {
+		t.Fatalf("expected the SOA to be kept, got %d records", n)
+	}
+}
+
+func TestMockVultrDNSZoneRecordsNormalizedData(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	newMockLifecycle(t, api, "vultr_dns_domain").
+		apply(map[string]interface{}{"domain": "norm.example.com"})
+
+	// the API quotes TXT data, and host names may be written with or
+	// without a trailing dot
+	config := map[string]interface{}{
+		"domain": "norm.example.com",
+		"record": []interface{}{
+			map[string]interface{}{"type": "TXT", "data": "v=spf1 -all"},
+			map[string]interface{}{"name": "quoted", "type": "TXT", "data": `"already quoted"`},
+			map[string]interface{}{"name": "www", "type": "CNAME", "data": "norm.example.com."},
+			map[string]interface{}{"type": "MX", "data": "mail.example.com.", "priority": 10},
+		},
+	}
+
+	newMockLifecycle(t, api, "vultr_dns_zone_records").
+		apply(config).
+		check(map[string]string{"record.#": "4"}).
+		planEmpty(config).
+		refresh().
+		planEmpty(config).
+		destroy()
+
+	if n := api.count("/v2/domains/norm.example.com/records"); n != 3 {
+		t.Fatalf("expected only the SOA and NS records to remain, got %d records", n)
+	}
+}
+
+func TestMockVultrDNSZoneFileLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	newMockLifecycle(t, api, "vultr_dns_domain").
+		apply(map[string]interface{}{"domain": "bind.example.com", "ip": "192.0.2.10"})
+
+	zone := `$ORIGIN bind.example.com.
+$TTL 300
+@	IN	SOA	ns1.old-host.net. hostmaster.bind.example.com. ( 1 7200 3600 1209600 300 )
+@	IN	NS	ns1.old-host.net.
+@	IN	A	192.0.2.10
+www	IN	CNAME	@
+@	IN	MX	10 mail.example.net.
+`
+	config := map[string]interface{}{"domain": "bind.example.com", "zone_file": zone}
+
+	l := newMockLifecycle(t, api, "vultr_dns_zone_file").
+		apply(config).
+		check(map[string]string{"record_count": "3", "zone_file": zone}).
+		planEmpty(config)
+
+	// reordering the file or dropping comments is not a change
+	config["zone_file"] = "www 300 IN CNAME bind.example.com.\n@ 300 IN MX 10 mail.example.net.\n@ 300 IN A 192.0.2.10\n"
+	l.planEmpty(config)
+
+	config["zone_file"] = zone + "api\tIN\tA\t192.0.2.20\n"
+	l.apply(config).check(map[string]string{"record_count": "4"})
+
+	// the data source leaves the managed NS records out like the resources,
+	// unless keep_managed_records is false
+	ns := "@\t300\tIN\tNS\tns1.vultr.com.\n"
+	for _, keep := range []bool{true, false} {
+		ds := dataSourceVultrDNSZoneFile()
+		d := ds.TestResourceData()
+		if err := d.Set("domain", "bind.example.com"); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Set("keep_managed_records", keep); err != nil {
+			t.Fatal(err)
+		}
+		if diags := ds.ReadContext(context.Background(), d, api.client(t)); diags.HasError() {
+			t.Fatalf("read: %v", diags)
+		}
+
+		zoneFile := d.Get("zone_file").(string)
+		if !strings.Contains(zoneFile, "api\t300\tIN\tA\t192.0.2.20\n") {
+			t.Errorf("zone file is missing the api record:\n%s", zoneFile)
+		}
+		if strings.Contains(zoneFile, ns) == keep {
+			t.Errorf("expected the NS record in the zone file to be %t with keep_managed_records %t:\n%s", !keep, keep, zoneFile)
+		}
+	}
+
+	l.importVerify("bind.example.com", "zone_file").destroy()
+
+	if n := api.count("/v2/domains/bind.example.com/records"); n != 3 {
+		t.Fatalf("expected only the SOA and NS records to remain, got %d records", n)
+	}
+}
+
+func TestMockVultrFirewallLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	group := newMockLifecycle(t, api, "vultr_firewall_group").
+		apply(map[string]interface{}{"description": "mock"}).
+		check(map[string]string{"description": "mock"})
+
+	rule := newMockLifecycle(t, api, "vultr_firewall_rule").
+		apply(map[string]interface{}{
+			"firewall_group_id": group.state.ID,
+			"protocol":          "tcp",
+			"ip_type":           "v4",
+			"subnet":            "198.51.100.0",
+			"subnet_size":       24,
+			"port":              "8080",
+			"notes":             "mock rule",
+		}).
+		check(map[string]string{"port": "8080", "subnet_size": "24"})
+	rule.importVerify(fmt.Sprintf("%s,%s", group.state.ID, rule.state.ID))
+
+	group.apply(map[string]interface{}{"description": "mock-updated"}).
+		check(map[string]string{"description": "mock-updated"}).
+		importVerify("")
+
+	rule.destroy()
+	group.destroy()
+}
+
+func TestMockVultrFirewallGroupRulesLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	group := newMockLifecycle(t, api, "vultr_firewall_group").
+		apply(map[string]interface{}{"description": "audited"})
+	rules := "/v2/firewalls/" + group.state.ID + "/rules"
+
+	ssh := map[string]interface{}{
+		"ip_type": "v4", "protocol": "tcp", "subnet": "198.51.100.0", "subnet_size": 24, "port": "22",
+	}
+	https := map[string]interface{}{
+		"ip_type": "v6", "protocol": "tcp", "subnet": "2001:db8:0::0", "subnet_size": 32, "port": "443",
+	}
+	icmp := map[string]interface{}{"ip_type": "v4", "protocol": "icmp", "subnet": "0.0.0.0", "subnet_size": 0}
+
+	config := map[string]interface{}{
+		"firewall_group_id": group.state.ID,
+		"rule":              []interface{}{ssh, https, icmp},
+	}
+	l := newMockLifecycle(t, api, "vultr_firewall_group_rules").
+		apply(config).
+		check(map[string]string{"rule.#": "3"}).
+		planEmpty(config)
+
+	// a rule added in the portal is drift
+	api.put(rules, "99", map[string]interface{}{
+		"id": 99, "action": "accept", "ip_type": "v4", "protocol": "udp", "subnet": "0.0.0.0", "subnet_size": 0,
+		"port": "53", "source": "", "notes": "",
+	})
+	l.refresh().check(map[string]string{"rule.#": "4"})
+
+	// reordering is not a change, and changing one rule only replaces that rule
+	before := api.requestCount("POST", rules)
+	ssh["port"] = "2222"
+	config["rule"] = []interface{}{icmp, ssh, https}
+	l.apply(config).
+		check(map[string]string{"rule.#": "3"}).
+		planEmpty(config)
+	if n := api.requestCount("POST", rules) - before; n != 1 {
+		t.Fatalf("expected 1 rule to be created, got %d", n)
+	}
+	if _, ok := api.get(rules, "99"); ok {
+		t.Fatal("expected the unmanaged rule to be removed")
+	}
+
+	l.importVerify("")
+	l.destroy()
+	if n := api.count(rules); n != 0 {
+		t.Fatalf("expected the rules to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrFirewallGroupAllowLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	group := newMockLifecycle(t, api, "vultr_firewall_group").
+		apply(map[string]interface{}{"description": "offices"})
+	rules := "/v2/firewalls/" + group.state.ID + "/rules"
+
+	web := map[string]interface{}{
+		"protocol":   "tcp",
+		"port":       "8000:8080",
+		"cidrs":      []interface{}{"192.0.2.0/24", "198.51.100.7/32", "2001:db8:0::/48"},
+		"cloudflare": true,
+		"notes":      "web",
+	}
+	config := map[string]interface{}{
+		"firewall_group_id": group.state.ID,
+		"allow":             []interface{}{web},
+	}
+	l := newMockLifecycle(t, api, "vultr_firewall_group_rules").
+		apply(config).
+		check(map[string]string{"allow.#": "1", "rule.#": "0"}).
+		planEmpty(config)
+
+	// each cidr is a rule of its own type, cloudflare is a v4 and a v6 rule
+	types := map[string]int{}
+	for _, r := range api.list(rules) {
+		types[fmt.Sprint(r["ip_type"])]++
+	}
+	if types["v4"] != 3 || types["v6"] != 2 {
+		t.Fatalf("expected 3 v4 and 2 v6 rules, got %v", types)
+	}
+
+	// a rule deleted in the portal is drift, and only that rule is put back
+	for _, r := range api.list(rules) {
+		if r["ip_type"] == "v6" && r["source"] == "" {
+			api.remove(rules, fmt.Sprint(r["id"]))
+		}
+	}
+	before := api.requestCount("POST", rules)
+	l.refresh().apply(config).planEmpty(config)
+	if n := api.requestCount("POST", rules) - before; n != 1 {
+		t.Fatalf("expected 1 rule to be created, got %d", n)
+	}
+
+	// adding a cidr only adds its rule
+	before = api.requestCount("POST", rules)
+	web["cidrs"] = []interface{}{"192.0.2.0/24", "198.51.100.7/32", "2001:db8:0::/48", "203.0.113.0/24"}
+	l.apply(config).planEmpty(config)
+	if n := api.requestCount("POST", rules) - before; n != 1 {
+		t.Fatalf("expected 1 rule to be created, got %d", n)
+	}
+	if n := api.count(rules); n != 6 {
+		t.Fatalf("expected 6 rules, got %d", n)
+	}
+
+	l.destroy()
+	if n := api.count(rules); n != 0 {
+		t.Fatalf("expected the rules to be deleted, %d remain", n)
+	}
+}
+
+func TestFirewallGroupRulesPlanValidation(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+	res := resourceVultrFirewallGroupRules()
+	client := api.client(t)
+
+	for name, tc := range map[string]struct {
+		allow map[string]interface{}
+		err   string
+	}{
+		"port range": {
+			allow: map[string]interface{}{"protocol": "tcp", "port": "9000:8000", "cidrs": []interface{}{"192.0.2.0/24"}},
+			err:   "port range",
+		},
+		"port out of range": {
+			allow: map[string]interface{}{"protocol": "udp", "port": "70000", "cidrs": []interface{}{"192.0.2.0/24"}},
+			err:   "valid port",
+		},
+		"port on icmp": {
+			allow: map[string]interface{}{"protocol": "icmp", "port": "22", "cidrs": []interface{}{"192.0.2.0/24"}},
+			err:   "only be set for tcp and udp",
+		},
+		"no source": {
+			allow: map[string]interface{}{"protocol": "tcp", "port": "22"},
+			err:   "needs at least one of cidrs",
+		},
+		"bad cidr": {
+			allow: map[string]interface{}{"protocol": "tcp", "cidrs": []interface{}{"192.0.2.1"}},
+			err:   "CIDR",
+		},
+	} {
+		cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"firewall_group_id": "group",
+			"allow":             []interface{}{tc.allow},
+		})
+
+		var err error
+		if diags := res.Validate(cfg); diags.HasError() {
+			err = fmt.Errorf("%v", diags)
+		} else {
+			_, err = res.Diff(context.Background(), nil, cfg, client)
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestMockVultrFirewallGroupAttachmentLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	security := newMockLifecycle(t, api, "vultr_firewall_group").
+		apply(map[string]interface{}{"description": "security"})
+	other := newMockLifecycle(t, api, "vultr_firewall_group").
+		apply(map[string]interface{}{"description": "other"})
+	instanceConfig := map[string]interface{}{"plan": "vc2-1c-1gb", "region": "ewr", "os_id": 1743, "label": "web"}
+	instance := newMockLifecycle(t, api, "vultr_instance").apply(instanceConfig)
+
+	config := map[string]interface{}{"instance_id": instance.state.ID, "firewall_group_id": security.state.ID}
+	l := newMockLifecycle(t, api, "vultr_firewall_group_attachment").
+		apply(config).
+		check(map[string]string{"firewall_group_id": security.state.ID}).
+		planEmpty(config).
+		importVerify("")
+
+	// the instance picks the group up without a change of its own
+	instance.refresh().
+		check(map[string]string{"firewall_group_id": security.state.ID}).
+		planEmpty(instanceConfig)
+	instanceConfig["label"] = "web-renamed"
+	instance.apply(instanceConfig).check(map[string]string{"firewall_group_id": security.state.ID})
+
+	// a second attachment for the same instance is a conflict
+	newMockLifecycle(t, api, "vultr_firewall_group_attachment").
+		applyError(map[string]interface{}{"instance_id": instance.state.ID, "firewall_group_id": other.state.ID},
+			"is already in firewall group "+security.state.ID)
+
+	l.destroy()
+	if got, _ := api.get("/v2/instances", instance.state.ID); got["firewall_group_id"] != "" {
+		t.Fatalf("expected the instance to leave the firewall group, got %v", got["firewall_group_id"])
+	}
+
+	// moving the instance elsewhere drops the attachment, and the next apply
+	// reports the conflict instead of moving it back
+	l.apply(config)
+	instanceConfig["firewall_group_id"] = other.state.ID
+	instance.apply(instanceConfig)
+	l.refresh()
+	if l.state != nil {
+		t.Fatalf("expected the attachment to be removed from state, got %s", l.state.ID)
+	}
+	l.applyError(config, "is already in firewall group "+other.state.ID)
+}
+
+func TestMockVultrVPCLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{"region": "ewr", "description": "mock-vpc"}
+
+	l := newMockLifecycle(t, api, "vultr_vpc").
+		apply(config).
+		check(map[string]string{"region": "ewr", "v4_subnet_mask": "20"}).
+		planEmpty(config)
+
+	config["description"] = "mock-vpc-updated"
+	l.apply(config).
+		check(map[string]string{"description": "mock-vpc-updated"}).
+		importVerify("").
+		destroy()
+
+	if n := api.count("/v2/vpcs"); n != 0 {
+		t.Fatalf("expected vpc to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrLoadBalancerLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"region":              "ewr",
+		"label":               "mock-lb",
+		"balancing_algorithm": "roundrobin",
+		"forwarding_rules": []interface{}{
+			map[string]interface{}{
+				"frontend_protocol": "http",
+				"frontend_port":     80,
+				"backend_protocol":  "http",
+				"backend_port":      8080,
+			},
+		},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_load_balancer").
+		apply(config).
+		check(map[string]string{
+			"label":              "mock-lb",
+			"status":             "active",
+			"forwarding_rules.#": "1",
+			"health_check.#":     "1",
+		})
+
+	config["balancing_algorithm"] = "leastconn"
+	config["label"] = "mock-lb-updated"
+	l.apply(config).
+		check(map[string]string{"balancing_algorithm": "leastconn", "label": "mock-lb-updated"}).
+		importVerify("").
+		destroy()
+
+	if n := api.count("/v2/load-balancers"); n != 0 {
+		t.Fatalf("expected load balancer to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrLoadBalancerSSLLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	lb := newMockLifecycle(t, api, "vultr_load_balancer").
+		apply(map[string]interface{}{"region": "ewr", "label": "mock-lb-ssl"})
+	path := "/v2/load-balancers/" + lb.state.ID
+
+	notAfter := time.Now().Add(30 * 24 * time.Hour)
+	cert, key := testCertificate(t, notAfter, "example.com", "www.example.com")
+	config := map[string]interface{}{"load_balancer_id": lb.state.ID, "certificate": cert, "private_key": key}
+
+	l := newMockLifecycle(t, api, "vultr_load_balancer_ssl").
+		apply(config).
+		check(map[string]string{
+			"not_after":                   notAfter.UTC().Truncate(time.Second).Format(time.RFC3339),
+			"subject_alternative_names.#": "2",
+			"subject_alternative_names.1": "www.example.com",
+		}).
+		planEmpty(config)
+	lb.refresh().check(map[string]string{"has_ssl": "true"})
+
+	// a mismatched key or an expired certificate fails the plan
+	_, otherKey := testCertificate(t, notAfter, "example.net")
+	l.applyError(map[string]interface{}{"load_balancer_id": lb.state.ID, "certificate": cert, "private_key": otherKey},
+		"private key does not match")
+	expired, expiredKey := testCertificate(t, time.Now().Add(-time.Hour), "example.com")
+	l.applyError(
+		map[string]interface{}{"load_balancer_id": lb.state.ID, "certificate": expired, "private_key": expiredKey},
+		"certificate expired",
+	)
+
+	// rotation replaces the certificate in one update without removing it
+	rotated, rotatedKey := testCertificate(t, notAfter.Add(24*time.Hour), "example.com")
+	config["certificate"], config["private_key"] = rotated, rotatedKey
+	l.apply(config).
+		check(map[string]string{"subject_alternative_names.#": "1"}).
+		planEmpty(config)
+	if n := api.requestCount("PATCH", path); n != 2 {
+		t.Fatalf("expected 2 load balancer updates, got %d", n)
+	}
+	if n := api.requestCount("DELETE", path+"/ssl"); n != 0 {
+		t.Fatalf("expected the certificate to stay in place, got %d removals", n)
+	}
+
+	l.destroy()
+	lb.refresh().check(map[string]string{"has_ssl": "false"})
+}
+
+func TestMockVultrLoadBalancerRulesLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	// with allow_external_rules the load balancer keeps to its own rules and
+	// leaves those of the standalone resources alone
+	web := map[string]interface{}{
+		"frontend_protocol": "http", "frontend_port": 80, "backend_protocol": "http", "backend_port": 80,
+	}
+	lbConfig := map[string]interface{}{
+		"region":               "ewr",
+		"label":                "mock-lb-rules",
+		"allow_external_rules": true,
+		"forwarding_rules":     []interface{}{web},
+	}
+	lb := newMockLifecycle(t, api, "vultr_load_balancer").
+		apply(lbConfig).
+		check(map[string]string{"forwarding_rules.#": "1", "firewall_rules.#": "0"})
+	path := "/v2/load-balancers/" + lb.state.ID
+
+	forwarding := map[string]interface{}{
+		"load_balancer_id":  lb.state.ID,
+		"frontend_protocol": "tcp",
+		"frontend_port":     8443,
+		"backend_protocol":  "tcp",
+		"backend_port":      443,
+	}
+	fr := newMockLifecycle(t, api, "vultr_load_balancer_forwarding_rule").
+		apply(forwarding).
+		check(map[string]string{"frontend_port": "8443", "backend_port": "443"}).
+		planEmpty(forwarding)
+	fr.importVerify(lb.state.ID + "/" + fr.state.ID)
+
+	firewall := map[string]interface{}{
+		"load_balancer_id": lb.state.ID,
+		"port":             8443,
+		"ip_type":          "v4",
+		"source":           "192.0.2.0/24",
+	}
+	fw := newMockLifecycle(t, api, "vultr_load_balancer_firewall_rule").
+		apply(firewall).
+		check(map[string]string{"port": "8443", "source": "192.0.2.0/24"}).
+		planEmpty(firewall)
+	fw.importVerify(lb.state.ID + "/" + fw.state.ID)
+
+	lb.refresh().
+		check(map[string]string{"forwarding_rules.#": "1", "firewall_rules.#": "0"}).
+		planEmpty(lbConfig)
+
+	// rules of the load balancer are added and removed one at a time
+	lbConfig["label"] = "mock-lb-rules-renamed"
+	lbConfig["forwarding_rules"] = []interface{}{web, map[string]interface{}{
+		"frontend_protocol": "tcp", "frontend_port": 22, "backend_protocol": "tcp", "backend_port": 22,
+	}}
+	lbConfig["firewall_rules"] = []interface{}{map[string]interface{}{
+		"port": 22, "ip_type": "v4", "source": "198.51.100.0/24",
+	}}
+	lb.apply(lbConfig).
+		check(map[string]string{"forwarding_rules.#": "2", "firewall_rules.#": "1"}).
+		planEmpty(lbConfig)
+	if n, m := api.count(path+"/forwarding-rules"), api.count(path+"/firewall-rules"); n != 3 || m != 2 {
+		t.Fatalf("expected 3 forwarding and 2 firewall rules, got %d and %d", n, m)
+	}
+
+	lbConfig["forwarding_rules"] = []interface{}{web}
+	delete(lbConfig, "firewall_rules")
+	lb.apply(lbConfig).
+		check(map[string]string{"forwarding_rules.#": "1", "firewall_rules.#": "0"})
+	if n, m := api.count(path+"/forwarding-rules"), api.count(path+"/firewall-rules"); n != 2 || m != 1 {
+		t.Fatalf("expected the standalone rules to be kept, got %d forwarding and %d firewall rules", n, m)
+	}
+	fr.refresh().planEmpty(forwarding)
+	fw.refresh().planEmpty(firewall)
+
+	// a rule removed outside of terraform drops out of state
+	api.remove(path+"/firewall-rules", fw.state.ID)
+	fw.refresh()
+	if fw.state != nil {
+		t.Fatal("expected the removed firewall rule to be dropped from state")
+	}
+
+	fr.destroy()
+	lb.refresh().check(map[string]string{"forwarding_rules.#": "1", "firewall_rules.#": "0"})
+	if n := api.count(path + "/forwarding-rules"); n != 1 {
+		t.Fatalf("expected only the load balancer rule to remain, got %d", n)
+	}
+}
+
+func TestMockVultrLoadBalancerExclusiveRules(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"region": "ewr",
+		"label":  "mock-lb-exclusive",
+		"forwarding_rules": []interface{}{map[string]interface{}{
+			"frontend_protocol": "http", "frontend_port": 80, "backend_protocol": "http", "backend_port": 80,
+		}},
+		"firewall_rules": []interface{}{map[string]interface{}{
+			"port": 80, "ip_type": "v4", "source": "192.0.2.0/24",
+		}},
+	}
+	lb := newMockLifecycle(t, api, "vultr_load_balancer").
+		apply(config).
+		check(map[string]string{"forwarding_rules.#": "1", "firewall_rules.#": "1"})
+	path := "/v2/load-balancers/" + lb.state.ID
+
+	// removing the block removes the rules
+	delete(config, "firewall_rules")
+	lb.apply(config).
+		check(map[string]string{"firewall_rules.#": "0"}).
+		planEmpty(config)
+	if n := api.count(path + "/firewall-rules"); n != 0 {
+		t.Fatalf("expected the firewall rules to be removed, got %d", n)
+	}
+
+	// rules added elsewhere are read back and would be removed
+	api.put(path+"/forwarding-rules", "external", map[string]interface{}{
+		"id": "external", "frontend_protocol": "tcp", "frontend_port": 9000, "backend_protocol": "tcp", "backend_port": 9000,
+	})
+	lb.refresh().check(map[string]string{"forwarding_rules.#": "2"})
+
+	// turning on allow_external_rules stops managing them without removing them
+	config["allow_external_rules"] = true
+	lb.apply(config).
+		check(map[string]string{"forwarding_rules.#": "1"}).
+		planEmpty(config)
+	if _, ok := api.get(path+"/forwarding-rules", "external"); !ok {
+		t.Fatal("expected the external forwarding rule to be kept")
+	}
+}
+
+func TestMockVultrLoadBalancerAttachmentLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	lb := newMockLifecycle(t, api, "vultr_load_balancer").
+		apply(map[string]interface{}{
+			"region":             "ewr",
+			"label":              "mock-lb-attach",
+			"attached_instances": []interface{}{"static"},
+		})
+	instances := func() string {
+		obj, _ := api.get("/v2/load-balancers", lb.state.ID)
+		return strings.Join(mockStringList(obj["instances"]), ",")
+	}
+
+	single := map[string]interface{}{"load_balancer_id": lb.state.ID, "instance_id": "web-1"}
+	instance := newMockLifecycle(t, api, "vultr_load_balancer_attachment").
+		apply(single).
+		check(map[string]string{"instance_ids.#": "1"}).
+		planEmpty(single).
+		importVerify(lb.state.ID + "/web-1")
+
+	pools := "/v2/kubernetes/clusters/mock-vke/node-pools"
+	setNodes := func(poolID string, ids ...string) {
+		nodes := []interface{}{}
+		for _, id := range ids {
+			nodes = append(nodes, map[string]interface{}{"id": id, "status": "active"})
+		}
+		api.put(pools, poolID, map[string]interface{}{"id": poolID, "label": poolID, "nodes": nodes})
+	}
+	setNodes("mock-pool", "node-1", "node-2")
+	setNodes("other-pool", "other-1")
+
+	poolConfig := map[string]interface{}{
+		"load_balancer_id": lb.state.ID,
+		"cluster_id":       "mock-vke",
+		"node_pool_id":     "mock-pool",
+	}
+	pool := newMockLifecycle(t, api, "vultr_load_balancer_attachment").
+		apply(poolConfig).
+		check(map[string]string{"instance_ids.#": "2"}).
+		planEmpty(poolConfig).
+		importVerify(lb.state.ID + "/mock-vke/mock-pool")
+	if got := instances(); got != "static,web-1,node-1,node-2" {
+		t.Fatalf("unexpected load balancer instances %s", got)
+	}
+
+	// a replaced node is attached and the node that left is detached
+	setNodes("mock-pool", "node-2", "node-3")
+	pool.refresh().apply(poolConfig).
+		check(map[string]string{"instance_ids.#": "2"}).
+		planEmpty(poolConfig)
+	if got := instances(); got != "static,web-1,node-2,node-3" {
+		t.Fatalf("unexpected load balancer instances after node churn %s", got)
+	}
+
+	// an instance detached elsewhere drops out of state
+	obj, _ := api.get("/v2/load-balancers", lb.state.ID)
+	obj["instances"] = []interface{}{"static", "node-2", "node-3"}
+	instance.refresh()
+	if instance.state != nil {
+		t.Fatal("expected the detached instance to be dropped from state")
+	}
+
+	// moving to another pool swaps the nodes in place
+	poolConfig["node_pool_id"] = "other-pool"
+	pool.apply(poolConfig).
+		check(map[string]string{"id": lb.state.ID + "/mock-vke/other-pool", "instance_ids.#": "1"})
+	if got := instances(); got != "static,other-1" {
+		t.Fatalf("unexpected load balancer instances after moving pools %s", got)
+	}
+
+	pool.destroy()
+	if got := instances(); got != "static" {
+		t.Fatalf("expected only the static instance to remain, got %s", got)
+	}
+	lb.refresh().check(map[string]string{"attached_instances.#": "1"})
+}
+
+func TestMockVultrLoadBalancerBackends(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	for id, serverStatus := range map[string]string{"web-1": "ok", "web-2": "installingbooting"} {
+		api.put("/v2/instances", id, map[string]interface{}{
+			"id": id, "label": id, "status": "active", "power_status": "running", "server_status": serverStatus,
+		})
+	}
+
+	config := map[string]interface{}{
+		"region":                  "ewr",
+		"label":                   "mock-lb-backends",
+		"attached_instances":      []interface{}{"web-1", "web-2", "gone"},
+		"wait_for_ready_backends": 1,
+	}
+	l := newMockLifecycle(t, api, "vultr_load_balancer").
+		apply(config).
+		check(map[string]string{"wait_for_ready_backends": "1"})
+
+	ds := dataSourceVultrLoadBalancerBackends()
+	d := ds.TestResourceData()
+	if err := d.Set("load_balancer_id", l.state.ID); err != nil {
+		t.Fatal(err)
+	}
+	if diags := ds.ReadContext(context.Background(), d, api.client(t)); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	for k, want := range map[string]interface{}{
+		"status":                    "active",
+		"has_ssl":                   false,
+		"ready_backends":            1,
+		"backends.#":                3,
+		"backends.0.instance_ready": true,
+		"backends.1.server_status":  "installingbooting",
+		"backends.2.status":         "missing",
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("expected %s to be %v, got %v", k, want, got)
+		}
+	}
+
+	// more backends than are attached can never become ready
+	config["wait_for_ready_backends"] = 4
+	l.applyError(config, "wait_for_ready_backends is 4 but only 3 attached_instances are set")
+
+	// the update waits until the second backend passes
+	go func() {
+		time.Sleep(6 * time.Second)
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		obj, _ := api.get("/v2/instances", "web-2")
+		obj["server_status"] = "ok"
+	}()
+	config["wait_for_ready_backends"] = 2
+	l.apply(config).check(map[string]string{"wait_for_ready_backends": "2"})
+	if n := api.requestCount("GET", "/v2/instances/web-2"); n < 3 {
+		t.Fatalf("expected the update to poll the backend until it was ready, got %d requests", n)
+	}
+
+	// instances attached later, such as by a vultr_load_balancer_attachment,
+	// can't be waited for, so the plan fails before anything is created
+	before := api.count("/v2/load-balancers")
+	later := map[string]interface{}{"region": "ewr", "label": "mock-lb-attach-later", "wait_for_ready_backends": 2}
+	newMockLifecycle(t, api, "vultr_load_balancer").
+		withRawConfig(later).
+		applyError(later, "wait_for_ready_backends requires attached_instances to be set")
+	if n := api.count("/v2/load-balancers"); n != before {
+		t.Fatalf("expected no load balancer to be created, got %d", n-before)
+	}
+}
+
+func TestMockVultrDatabaseLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"database_engine":         "pg",
+		"database_engine_version": "15",
+		"region":                  "ewr",
+		"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+		"label":                   "mock-db",
+	}
+
+	l := newMockLifecycle(t, api, "vultr_database").
+		apply(config).
+		check(map[string]string{"status": "Running", "user": "vultradmin", "label": "mock-db"})
+
+	config["label"] = "mock-db-updated"
+	config["database_engine_version"] = "16"
+	l.apply(config).
+		check(map[string]string{"label": "mock-db-updated", "database_engine_version": "16"}).
+		importVerify("", "password", "upgrade_status", "upgrade_previous_version").
+		destroy()
+
+	if n := api.count("/v2/databases"); n != 0 {
+		t.Fatalf("expected database to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrDatabaseRestoreLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	source := newMockLifecycle(t, api, "vultr_database").
+		apply(map[string]interface{}{
+			"database_engine":         "pg",
+			"database_engine_version": "15",
+			"region":                  "ewr",
+			"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+			"label":                   "mock-db-source",
+		})
+
+	ds := dataSourceVultrDatabaseBackups()
+	d := ds.TestResourceData()
+	if err := d.Set("database_id", source.state.ID); err != nil {
+		t.Fatal(err)
+	}
+	if diags := ds.ReadContext(context.Background(), d, api.client(t)); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	latest, err := time.Parse(time.RFC3339, d.Get("latest_backup.0.timestamp").(string))
+	if err != nil {
+		t.Fatalf("expected an RFC 3339 latest backup timestamp: %v", err)
+	}
+	if _, err := time.Parse(time.RFC3339, d.Get("oldest_backup.0.timestamp").(string)); err != nil {
+		t.Fatalf("expected an RFC 3339 oldest backup timestamp: %v", err)
+	}
+
+	at := latest.Add(-time.Hour)
+	restore := map[string]interface{}{"database_id": source.state.ID, "type": "pitr", "timestamp": at.Format(time.RFC3339)}
+	config := map[string]interface{}{
+		"database_engine":         "pg",
+		"database_engine_version": "15",
+		"region":                  "ewr",
+		"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+		"label":                   "mock-db-restored",
+		"tag":                     "dr-drill",
+		"trusted_ips":             []interface{}{"192.0.2.1/32"},
+		"backup_hour":             "3",
+		"backup_minute":           "30",
+		"restore_from":            []interface{}{restore},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_database")
+	l.applyError(map[string]interface{}{
+		"database_engine": "mysql", "database_engine_version": "8", "region": "ewr",
+		"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "label": "mock-db-restored", "restore_from": []interface{}{restore},
+	}, "does not match the pg engine")
+	l.applyError(map[string]interface{}{
+		"database_engine": "pg", "database_engine_version": "16", "region": "ewr",
+		"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "label": "mock-db-restored", "restore_from": []interface{}{restore},
+	}, `database_engine_version "16" does not match version 15 of restore_from database`)
+	l.applyError(map[string]interface{}{
+		"database_engine": "pg", "database_engine_version": "15", "region": "ewr",
+		"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "label": "mock-db-restored",
+		"restore_from": []interface{}{map[string]interface{}{"database_id": source.state.ID, "type": "pitr"}},
+	}, "timestamp is required for a pitr restore")
+	l.applyError(map[string]interface{}{
+		"database_engine": "pg", "database_engine_version": "15", "region": "ewr",
+		"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "label": "mock-db-restored",
+		"restore_from": []interface{}{map[string]interface{}{
+			"database_id": source.state.ID, "type": "pitr", "timestamp": latest.AddDate(0, -1, 0).Format(time.RFC3339),
+		}},
+	}, "is outside of the backups")
+
+	// a timestamp that is only known at apply, such as one read from the
+	// vultr_database_backups data source, is not checked while planning
+	diff, err := l.res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"database_engine": "pg", "database_engine_version": "15", "region": "ewr",
+		"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "label": "mock-db-restored",
+		"restore_from": []interface{}{map[string]interface{}{
+			"database_id": source.state.ID, "type": "pitr", "timestamp": mockUnknownValue,
+		}},
+	}), l.meta)
+	if err != nil || diff == nil {
+		t.Fatalf("expected a plan with an unknown timestamp, got %v", err)
+	}
+
+	l.apply(config).
+		check(map[string]string{
+			"label":                   "mock-db-restored",
+			"tag":                     "dr-drill",
+			"trusted_ips.#":           "1",
+			"backup_hour":             "3",
+			"restore_from.0.type":     "pitr",
+			"database_engine_version": "15",
+		}).
+		planEmpty(config)
+
+	obj, _ := api.get("/v2/databases", l.state.ID)
+	fork := obj["_fork"].(map[string]interface{})
+	if fork["type"] != "pitr" || fork["date"] != at.UTC().Format("2006-01-02") ||
+		fork["time"] != at.UTC().Format("15:04:05") {
+		t.Fatalf("unexpected fork request %v", fork)
+	}
+	if n := api.requestCount("POST", "/v2/databases"); n != 1 {
+		t.Fatalf("expected only the source database to be created, got %d creates", n)
+	}
+}
+
+func TestMockVultrDatabaseAdvancedOptions(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"database_engine":         "pg",
+		"database_engine_version": "15",
+		"region":                  "ewr",
+		"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+		"label":                   "mock-db-options",
+		"backup_hour":             "3",
+		"backup_minute":           "30",
+		"advanced_options":        map[string]interface{}{"jit": "true", "max_worker_processes": "16"},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_database").
+		apply(config).
+		check(map[string]string{
+			"advanced_options.%":                    "2",
+			"advanced_options.max_worker_processes": "16",
+			"restart_required_options.#":            "1",
+			"restart_required_options.0":            "max_worker_processes",
+		}).
+		planEmpty(config)
+
+	obj, _ := api.get("/v2/databases", l.state.ID)
+	options := obj["_advanced_options"].(map[string]interface{})
+	if options["jit"] != true || options["max_worker_processes"] != float64(16) {
+		t.Fatalf("expected typed advanced options, got %v", options)
+	}
+
+	for value, msg := range map[string]string{
+		"max_worker_processes": "must be at most 96, got 200",
+		"shared_buffers":       `"shared_buffers" is not an advanced option of the pg engine`,
+		"log_error_verbosity":  "must be one of TERSE, DEFAULT, VERBOSE",
+	} {
+		bad := map[string]interface{}{}
+		for k, v := range config {
+			bad[k] = v
+		}
+		bad["advanced_options"] = map[string]interface{}{value: map[string]string{
+			"max_worker_processes": "200", "shared_buffers": "128", "log_error_verbosity": "LOUD",
+		}[value]}
+		l.applyError(bad, msg)
+	}
+
+	// a new database is checked at plan time against the options of the
+	// existing one running the same engine version
+	second := map[string]interface{}{}
+	for k, v := range config {
+		second[k] = v
+	}
+	second["label"] = "mock-db-options-2"
+	second["advanced_options"] = map[string]interface{}{"shared_buffers": "128"}
+	_, err := l.res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(second), l.meta)
+	if msg := `"shared_buffers" is not an advanced option of the pg engine`; err == nil || err.Error() != msg {
+		t.Fatalf("expected the new database to be checked at plan time, got %v", err)
+	}
+
+	// another engine version may have other options, so it isn't checked
+	// against the existing database
+	second["database_engine_version"] = "16"
+	if _, err := l.res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(second), l.meta); err != nil {
+		t.Fatalf("expected no plan time check for another engine version, got %v", err)
+	}
+
+	config["advanced_options"] = map[string]interface{}{
+		"jit": "true", "autovacuum_analyze_scale_factor": "0.20", "temp_file_limit": "-1",
+	}
+	l.apply(config).
+		check(map[string]string{
+			"advanced_options.%": "3",
+			"advanced_options.autovacuum_analyze_scale_factor": "0.2",
+			"restart_required_options.#":                       "0",
+		}).
+		planEmpty(config)
+
+	// the removed option is reset to the engine default
+	if _, ok := options["max_worker_processes"]; ok || options["temp_file_limit"] != float64(-1) {
+		t.Fatalf("unexpected advanced options after update %v", options)
+	}
+
+	options["jit"] = false
+	l.refresh().
+		check(map[string]string{"advanced_options.jit": "false"}).
+		importVerify("", "password", "advanced_options", "restart_required_options").
+		destroy()
+}
+
+func TestMockVultrDatabaseVersionUpgrade(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"database_engine":         "pg",
+		"database_engine_version": "15",
+		"region":                  "ewr",
+		"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+		"label":                   "mock-db-upgrade",
+		"backup_hour":             "3",
+		"backup_minute":           "30",
+	}
+
+	l := newMockLifecycle(t, api, "vultr_database").
+		apply(config).
+		check(map[string]string{"upgrade_status": "", "upgrade_previous_version": ""})
+
+	config["database_engine_version"] = "17"
+	l.applyError(config,
+		`database_engine_version "17" is not an available upgrade from version "15", available upgrades: 16`)
+	if n := api.requestCount("POST", "/v2/databases/"+l.state.ID+"/version-upgrade"); n != 0 {
+		t.Fatalf("expected no upgrade to start, got %d", n)
+	}
+
+	// the failed upgrade is kept in state with the version the database runs
+	obj, _ := api.get("/v2/databases", l.state.ID)
+	obj["_upgrade_fails"] = true
+	config["database_engine_version"] = "16"
+	diff, err := l.res.Diff(context.Background(), l.state, terraform.NewResourceConfigRaw(config), l.meta)
+	if err != nil {
+		t.Fatalf("error planning: %v", err)
+	}
+	state, diags := l.res.Apply(context.Background(), l.state, diff, l.meta)
+	msg := "from version 15 to 16 : database upgrade was rolled back : database is running version 15"
+	if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), msg) {
+		t.Fatalf("expected the upgrade to fail, got %v", diags)
+	}
+	l.state = state
+	l.check(map[string]string{
+		"database_engine_version":  "15",
+		"upgrade_status":           "failed",
+		"upgrade_previous_version": "15",
+	})
+
+	delete(obj, "_upgrade_fails")
+	l.apply(config).
+		check(map[string]string{
+			"database_engine_version":  "16",
+			"upgrade_status":           "succeeded",
+			"upgrade_previous_version": "15",
+		}).
+		planEmpty(config)
+
+	// an error status while upgrading doesn't end the wait
+	obj["_upgrade_errors"] = true
+	config["database_engine_version"] = "17"
+	l.apply(config).
+		check(map[string]string{
+			"database_engine_version":  "17",
+			"upgrade_status":           "succeeded",
+			"upgrade_previous_version": "16",
+		})
+}
+
+func TestMockVultrDatabaseVersionUpgradeTimeout(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"database_engine":         "pg",
+		"database_engine_version": "15",
+		"region":                  "ewr",
+		"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+		"label":                   "mock-db-upgrade-timeout",
+		"backup_hour":             "3",
+		"backup_minute":           "30",
+	}
+
+	l := newMockLifecycle(t, api, "vultr_database").apply(config)
+
+	// the wait ends before the database is polled, so the upgrade is still
+	// running with the target version kept in state
+	obj, _ := api.get("/v2/databases", l.state.ID)
+	obj["_upgrade_fails"] = true
+	config["database_engine_version"] = "16"
+	config["timeouts"] = []interface{}{map[string]interface{}{"update": "1s"}}
+	diff, err := l.res.Diff(context.Background(), l.state, terraform.NewResourceConfigRaw(config), l.meta)
+	if err != nil {
+		t.Fatalf("error planning: %v", err)
+	}
+	state, diags := l.res.Apply(context.Background(), l.state, diff, l.meta)
+	msg := "from version 15 to 16 : context deadline exceeded"
+	if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), msg) {
+		t.Fatalf("expected the upgrade wait to time out, got %v", diags)
+	}
+	l.state = state
+	l.check(map[string]string{
+		"database_engine_version":  "16",
+		"upgrade_status":           "in_progress",
+		"upgrade_previous_version": "15",
+	}).
+		planEmpty(config)
+
+	// a refresh while the database is rebuilding keeps the upgrade running
+	l.refresh().
+		check(map[string]string{"database_engine_version": "16", "upgrade_status": "in_progress"})
+
+	// the upgrade was rolled back once the database runs the old version again
+	l.refresh().
+		check(map[string]string{"database_engine_version": "15", "upgrade_status": "failed"})
+}
+
+func TestMockVultrDatabaseMigrationLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	db := newMockLifecycle(t, api, "vultr_database").
+		apply(map[string]interface{}{
+			"database_engine":         "mysql",
+			"database_engine_version": "8",
+			"region":                  "ewr",
+			"plan":                    "vultr-dbaas-hobbyist-cc-1-25-1",
+			"label":                   "mock-db-target",
+		})
+
+	config := map[string]interface{}{
+		"database_id":       db.state.ID,
+		"host":              "legacy-db.example.com",
+		"port":              3306,
+		"username":          "replicator",
+		"password":          "source-password",
+		"ignored_databases": []interface{}{"sys", "scratch"},
+	}
+
+	l := newMockLifecycle(t, api, "vultr_database_migration")
+	l.applyError(map[string]interface{}{
+		"database_id": db.state.ID,
+		"host":        "unreachable.example.com",
+		"port":        3306,
+		"username":    "replicator",
+		"password":    "source-password",
+	}, "migration failed: could not connect to unreachable.example.com")
+
+	l.apply(config).
+		check(map[string]string{
+			"status":              "done",
+			"method":              "replication",
+			"ssl":                 "true",
+			"ignored_databases.#": "2",
+			"password":            "source-password",
+		}).
+		planEmpty(config).
+		importVerify(db.state.ID, "password")
+
+	obj, _ := api.get("/v2/databases", db.state.ID)
+	migration := obj["_migration"].(map[string]interface{})
+	credentials := migration["credentials"].(map[string]interface{})
+	if credentials["ignored_databases"] != "sys,scratch" || credentials["ssl"] != true {
+		t.Fatalf("unexpected migration request %v", credentials)
+	}
+
+	l.destroy()
+	if _, ok := obj["_migration"]; ok {
+		t.Fatal("expected the migration to be detached")
+	}
+}
+
+func TestMockVultrKubernetesLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{
+		"region":  "ewr",
+		"label":   "mock-vke",
+		"version": "v1.30.0+1",
+		"node_pools": []interface{}{
+			map[string]interface{}{
+				"node_quantity": 2,
+				"plan":          "vc2-2c-4gb",
+				"label":         "mock-pool",
+			},
+		},
+	}
+
+	cluster := newMockLifecycle(t, api, "vultr_kubernetes").
+		apply(config).
+		check(map[string]string{
+			"status":                 "active",
+			"node_pools.#":           "1",
+			"node_pools.0.nodes.#":   "2",
+			"node_pools.0.tag":       tfVKEDefault,
+			"client_key":             base64.StdEncoding.EncodeToString([]byte("mock-key")),
+			"client_certificate":     base64.StdEncoding.EncodeToString([]byte("mock-cert")),
+			"cluster_ca_certificate": base64.StdEncoding.EncodeToString([]byte("mock-ca")),
+		})
+
+	pool := newMockLifecycle(t, api, "vultr_kubernetes_node_pools").
+		apply(map[string]interface{}{
+			"cluster_id":    cluster.state.ID,
+			"node_quantity": 1,
+			"plan":          "vc2-1c-1gb",
+			"label":         "mock-extra",
+		}).
+		check(map[string]string{"nodes.#": "1"})
+
+	pool.apply(map[string]interface{}{
+		"cluster_id":    cluster.state.ID,
+		"node_quantity": 3,
+		"plan":          "vc2-1c-1gb",
+		"label":         "mock-extra",
+	}).
+		check(map[string]string{"node_quantity": "3", "nodes.#": "3"}).
+		importVerify(fmt.Sprintf("%s %s", cluster.state.ID, pool.state.ID))
+
+	pool.destroy()
+	cluster.importVerify("", "kube_config", "enable_firewall").destroy()
+
+	if n := api.count("/v2/kubernetes/clusters"); n != 0 {
+		t.Fatalf("expected cluster to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrCDNLifecycle(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	pullConfig := map[string]interface{}{
+		"label":         "mock-pull",
+		"origin_scheme": "https",
+		"origin_domain": "assets.example.com",
+		"gzip":          true,
+	}
+
+	pull := newMockLifecycle(t, api, "vultr_cdn_pull_zone").
+		apply(pullConfig).
+		check(map[string]string{"status": "active", "gzip": "true", "cors": "false", "regions.#": "2"}).
+		planEmpty(pullConfig)
+
+	pullConfig["block_ai"] = true
+	pullConfig["origin_scheme"] = "http"
+	pull.apply(pullConfig).
+		check(map[string]string{"block_ai": "true", "origin_scheme": "http"}).
+		importVerify("").
+		destroy()
+
+	pull.state = &terraform.InstanceState{ID: "missing"}
+	pull.gone()
+
+	push := newMockLifecycle(t, api, "vultr_cdn_push_zone").
+		apply(map[string]interface{}{"label": "mock-push", "cors": true}).
+		check(map[string]string{"cors": "true", "file_count": "0"})
+
+	endpoint := newMockLifecycle(t, api, "vultr_cdn_push_zone_upload_endpoint").
+		apply(map[string]interface{}{"zone_id": push.state.ID, "name": "app.js", "size": 2048}).
+		check(map[string]string{"inputs.key": "app.js", "inputs.policy": "mock-policy"})
+
+	api.put("/v2/cdns/push-zones/"+push.state.ID+"/files", "app.js", map[string]interface{}{
+		"name": "app.js", "size": 2048, "last_modified": mockTimestamp(),
+	})
+	push.refresh().
+		check(map[string]string{"file_count": "1", "total_size": "2048", "files.0.name": "app.js"}).
+		importVerify("")
+
+	push.destroy()
+	endpoint.gone()
+
+	if n := api.count("/v2/cdns/push-zones"); n != 0 {
+		t.Fatalf("expected push zone to be deleted, %d remain", n)
+	}
+}
+
+func TestMockVultrUnauthorized(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	client, err := (&Config{APIKey: "wrong", APIEndpoint: api.URL, RetryLimit: 1}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, _, err := client.govultrClient().Instance.List(context.Background(), nil); !isUnauthorizedError(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+
+	if _, _, err := api.client(t).govultrClient().Instance.Get(context.Background(), "missing"); !isNotFoundError(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestUnitVultrInstanceBasic(t *testing.T) {
+	api := newMockVultrAPI(t)
+	name := "vultr_instance.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testMockPreCheck(t) },
+		ProviderFactories: api.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testMockVultrInstance("mock-instance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", "mock-instance"),
+					resource.TestCheckResourceAttr(name, "os", "Ubuntu 22.04 LTS x64"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testMockVultrInstance("mock-instance-updated"),
+				Check:  resource.TestCheckResourceAttr(name, "label", "mock-instance-updated"),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_password", "activation_email", "ddos_protection", "enable_ipv6"},
+			},
+		},
+	})
+}
+
+func TestUnitVultrBlockStorageBasic(t *testing.T) {
+	api := newMockVultrAPI(t)
+	name := "vultr_block_storage.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testMockPreCheck(t) },
+		ProviderFactories: api.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + `
+					resource "vultr_block_storage" "test" {
+						region  = "ewr"
+						size_gb = 10
+						label   = "mock-block"
+					}`,
+				Check: resource.TestCheckResourceAttr(name, "size_gb", "10"),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"live"},
+			},
+		},
+	})
+}
+
+func TestUnitVultrDNSDomainBasic(t *testing.T) {
+	api := newMockVultrAPI(t)
+	name := "vultr_dns_domain.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testMockPreCheck(t) },
+		ProviderFactories: api.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + `
+					resource "vultr_dns_domain" "test" {
+						domain = "mock.example.com"
+						ip     = "192.0.2.10"
+					}
+
+					resource "vultr_dns_record" "test" {
+						domain = vultr_dns_domain.test.id
+						name   = "www"
+						type   = "A"
+						data   = "192.0.2.11"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domain", "mock.example.com"),
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "data", "192.0.2.11"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip"},
+			},
+		},
+	})
+}
+
+func TestUnitVultrFirewallGroupBasic(t *testing.T) {
+	api := newMockVultrAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testMockPreCheck(t) },
+		ProviderFactories: api.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + `
+					resource "vultr_firewall_group" "test" {
+						description = "mock"
+					}
+
+					resource "vultr_firewall_rule" "test" {
+						firewall_group_id = vultr_firewall_group.test.id
+						protocol          = "tcp"
+						ip_type           = "v4"
+						subnet            = "0.0.0.0"
+						subnet_size       = 0
+						port              = "22"
+					}`,
+				Check: resource.TestCheckResourceAttr("vultr_firewall_rule.test", "port", "22"),
+			},
+			{
+				ResourceName:      "vultr_firewall_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testMockVultrInstance(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_instance" "test" {
+			plan   = "vc2-1c-1gb"
+			region = "ewr"
+			os_id  = 1743
+			label  = %q
+			tags   = ["one", "two"]
+		}`, label)
+}
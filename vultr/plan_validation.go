@@ -0,0 +1,434 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+// checkRegionAvailability ensures a region exists and that a plan sold there
+// currently has capacity in it
+func checkRegionAvailability(ctx context.Context, client *Client, planID, region string) error {
+	regions, err := client.regions(ctx)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range regions {
+		if strings.EqualFold(regions[i].ID, region) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("region %q does not exist", region)
+	}
+
+	availability, _, err := client.govultrClient().Region.Availability(ctx, strings.ToLower(region), "")
+	if err != nil {
+		return fmt.Errorf("error getting plan availability for region %q: %v", region, err)
+	}
+
+	for _, id := range availability.AvailablePlans {
+		if id == planID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("plan %q is sold in region %q but currently has no capacity there", planID, region)
+}
+
+// checkPlanLocation returns an error naming where a plan is sold when it isn't
+// offered in region
+func checkPlanLocation(planID, region string, locations []string) error {
+	for _, l := range locations {
+		if strings.EqualFold(l, region) {
+			return nil
+		}
+	}
+
+	if len(locations) == 0 {
+		return fmt.Errorf("plan %q is not available in any region", planID)
+	}
+
+	sorted := append([]string{}, locations...)
+	sort.Strings(sorted)
+	return fmt.Errorf("plan %q is not available in region %q, it is offered in: %s",
+		planID, region, strings.Join(sorted, ", "))
+}
+
+// validateComputePlan checks a cloud compute plan against the operating system
+// it will be deployed with, when set, and, with placement, against its region
+// and the capacity left there
+func validateComputePlan(ctx context.Context, client *Client, planID, region string, osID int, placement bool) error { //nolint:lll
+	plans, err := client.plans(ctx)
+	if err != nil {
+		return err
+	}
+
+	var plan *govultr.Plan
+	for i := range plans {
+		if plans[i].ID == planID {
+			plan = &plans[i]
+			break
+		}
+	}
+	if plan == nil {
+		return fmt.Errorf("plan %q does not exist", planID)
+	}
+
+	if placement {
+		if err := checkPlanLocation(planID, region, plan.Locations); err != nil {
+			return err
+		}
+	}
+
+	if osID != 0 {
+		osList, err := client.operatingSystems(ctx)
+		if err != nil {
+			return err
+		}
+
+		var os *govultr.OS
+		for i := range osList {
+			if osList[i].ID == osID {
+				os = &osList[i]
+				break
+			}
+		}
+		if os == nil {
+			return fmt.Errorf("os_id %d does not exist", osID)
+		}
+
+		if plan.GPUType != "" && os.Arch != "x64" {
+			return fmt.Errorf("GPU plan %q requires a 64-bit operating system, os_id %d (%s) is %s",
+				planID, osID, os.Name, os.Arch)
+		}
+	}
+
+	if !placement {
+		return nil
+	}
+	return checkRegionAvailability(ctx, client, planID, region)
+}
+
+// planDiffKnown reports whether any of the keys changed and all of them are
+// known, which is when a plan time catalog check is both needed and possible
+func planDiffKnown(d *schema.ResourceDiff, keys ...string) bool {
+	for _, k := range keys {
+		if !d.NewValueKnown(k) {
+			return false
+		}
+	}
+
+	return d.Id() == "" || d.HasChanges(keys...)
+}
+
+// placementChanged reports whether the plan and region of an instance need
+// their location and capacity checked: on create, or when either changes. A
+// replacement for another os_id is planned again by the SDK without the prior
+// state, so the raw state Terraform sent is compared instead.
+func placementChanged(d *schema.ResourceDiff) bool {
+	if !planDiffKnown(d, "plan", "region") {
+		return false
+	}
+
+	prior := d.GetRawState()
+	if d.Id() != "" || prior.IsNull() {
+		return true
+	}
+
+	plan, region := prior.GetAttr("plan"), prior.GetAttr("region")
+	if !plan.IsKnown() || plan.IsNull() || !region.IsKnown() || region.IsNull() {
+		return true
+	}
+
+	return plan.AsString() != d.Get("plan").(string) || !strings.EqualFold(region.AsString(), d.Get("region").(string))
+}
+
+func resourceVultrInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := meta.(*Client).diffTagsAll(d); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("plan") || !d.NewValueKnown("region") || d.Get("plan").(string) == "" {
+		return nil
+	}
+
+	// os_id is unknown for instances created from an app, snapshot or ISO,
+	// which only skips the OS check. A reinstall with another os_id keeps the
+	// plan and region of the instance, so only the OS is checked then.
+	osID := 0
+	if d.NewValueKnown("os_id") && d.HasChange("os_id") {
+		osID = d.Get("os_id").(int)
+	}
+	placement := placementChanged(d)
+	if osID == 0 && !placement {
+		return nil
+	}
+
+	return validateComputePlan(ctx, meta.(*Client), d.Get("plan").(string), d.Get("region").(string), osID, placement)
+}
+
+func resourceVultrBareMetalServerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := meta.(*Client).diffTagsAll(d); err != nil {
+		return err
+	}
+
+	if !planDiffKnown(d, "plan", "region") {
+		return nil
+	}
+
+	client := meta.(*Client)
+	planID := d.Get("plan").(string)
+	region := d.Get("region").(string)
+
+	plans, err := client.bareMetalPlans(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range plans {
+		if plans[i].ID != planID {
+			continue
+		}
+
+		if err := checkPlanLocation(planID, region, plans[i].Locations); err != nil {
+			return err
+		}
+		return checkRegionAvailability(ctx, client, planID, region)
+	}
+
+	return fmt.Errorf("bare metal plan %q does not exist", planID)
+}
+
+func resourceVultrKubernetesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !planDiffKnown(d, "node_pools.0.plan", "region") {
+		return nil
+	}
+
+	planID := d.Get("node_pools.0.plan").(string)
+	if planID == "" {
+		return nil
+	}
+
+	return validateComputePlan(ctx, meta.(*Client), planID, d.Get("region").(string), 0, true)
+}
+
+func resourceVultrKubernetesNodePoolsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error { //nolint:lll
+	if !planDiffKnown(d, "plan", "cluster_id") {
+		return nil
+	}
+
+	client := meta.(*Client)
+	cluster, _, err := client.govultrClient().Kubernetes.GetCluster(ctx, d.Get("cluster_id").(string))
+	if err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("kubernetes cluster %q does not exist", d.Get("cluster_id"))
+		}
+		return fmt.Errorf("error getting kubernetes cluster %q: %v", d.Get("cluster_id"), err)
+	}
+
+	return validateComputePlan(ctx, client, d.Get("plan").(string), cluster.Region, 0, true)
+}
+
+func resourceVultrDatabaseCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDatabaseRestore(ctx, d, meta.(*Client)); err != nil {
+		return err
+	}
+	if err := validateDatabaseAdvancedOptions(ctx, d, meta.(*Client)); err != nil {
+		return err
+	}
+	if err := validateDatabaseVersionUpgrade(ctx, d, meta.(*Client)); err != nil {
+		return err
+	}
+
+	if !planDiffKnown(d, "plan", "region", "database_engine") {
+		return nil
+	}
+
+	planID := d.Get("plan").(string)
+	region := d.Get("region").(string)
+	engine := d.Get("database_engine").(string)
+
+	plans, err := meta.(*Client).databasePlans(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range plans {
+		if plans[i].ID != planID {
+			continue
+		}
+
+		if !databasePlanSupportsEngine(&plans[i], engine) {
+			return fmt.Errorf("database plan %q does not support the %s engine", planID, engine)
+		}
+		return checkPlanLocation(planID, region, plans[i].Locations)
+	}
+
+	return fmt.Errorf("database plan %q does not exist", planID)
+}
+
+// validateDatabaseAdvancedOptions checks changed advanced options against the
+// options of the engine, and plans which of them need a restart. An existing
+// database lists its own options; a new or upgraded one is checked against
+// another database running the same engine version, or once it is applied
+// when there is none.
+func validateDatabaseAdvancedOptions(ctx context.Context, d *schema.ResourceDiff, client *Client) error {
+	if !d.HasChange("advanced_options") || !d.NewValueKnown("advanced_options") || !d.NewValueKnown("database_engine") {
+		return nil
+	}
+
+	engine := d.Get("database_engine").(string)
+	raw := d.Get("advanced_options").(map[string]interface{})
+
+	if len(raw) != 0 && d.NewValueKnown("database_engine_version") {
+		var available []govultr.AvailableOption
+		found := true
+		if d.Id() != "" && !d.HasChange("database_engine") && !d.HasChange("database_engine_version") {
+			options, err := getDatabaseAdvancedOptions(ctx, client.govultrClient(), d.Id())
+			if err != nil {
+				return fmt.Errorf("error getting advanced options of database %s : %v", d.Id(), err)
+			}
+			available = options.AvailableOptions
+		} else {
+			var err error
+			version := d.Get("database_engine_version").(string)
+			if available, found, err = client.databaseEngineAdvancedOptions(ctx, engine, version); err != nil {
+				return err
+			}
+		}
+
+		if found {
+			if _, err := expandDatabaseAdvancedOptions(engine, raw, available); err != nil {
+				return err
+			}
+		}
+	}
+
+	return d.SetNew("restart_required_options", databaseRestartRequired(engine, raw))
+}
+
+// validateDatabaseVersionUpgrade checks a new engine version of an existing
+// database against the versions it can be upgraded to
+func validateDatabaseVersionUpgrade(ctx context.Context, d *schema.ResourceDiff, client *Client) error {
+	if d.Id() == "" || !d.HasChange("database_engine_version") || d.HasChange("database_engine") {
+		return nil
+	}
+	if !d.NewValueKnown("database_engine_version") {
+		return d.SetNewComputed("upgrade_status")
+	}
+
+	oldVal, newVal := d.GetChange("database_engine_version")
+	versions, _, err := client.govultrClient().Database.ListAvailableVersions(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting available versions of database %s : %v", d.Id(), err)
+	}
+	if !versionCompare(versions, newVal.(string)) {
+		available := "none"
+		if len(versions) != 0 {
+			available = strings.Join(versions, ", ")
+		}
+		return fmt.Errorf(
+			"database_engine_version %q is not an available upgrade from version %q, available upgrades: %s",
+			newVal, oldVal, available,
+		)
+	}
+
+	if err := d.SetNewComputed("upgrade_status"); err != nil {
+		return err
+	}
+	return d.SetNew("upgrade_previous_version", oldVal)
+}
+
+// validateDatabaseRestore checks a restore_from block of a new database
+// against its source: the engine has to match, and a point in time needs a
+// timestamp inside the backup window of the source
+func validateDatabaseRestore(ctx context.Context, d *schema.ResourceDiff, client *Client) error {
+	if d.Id() != "" || len(d.Get("restore_from").([]interface{})) == 0 {
+		return nil
+	}
+	known := []string{
+		"restore_from", "restore_from.0.database_id", "restore_from.0.timestamp",
+		"database_engine", "database_engine_version",
+	}
+	for _, k := range known {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	sourceID := d.Get("restore_from.0.database_id").(string)
+	restoreType := d.Get("restore_from.0.type").(string)
+	timestamp := d.Get("restore_from.0.timestamp").(string)
+
+	switch {
+	case restoreType == "pitr" && timestamp == "":
+		return fmt.Errorf("restore_from.0.timestamp is required for a pitr restore")
+	case restoreType != "pitr" && timestamp != "":
+		return fmt.Errorf("restore_from.0.timestamp is only used for a pitr restore")
+	}
+
+	source, _, err := client.govultrClient().Database.Get(ctx, sourceID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("restore_from database %q does not exist", sourceID)
+		}
+		return fmt.Errorf("error getting restore_from database %q: %v", sourceID, err)
+	}
+	if engine := d.Get("database_engine").(string); !strings.EqualFold(engine, source.DatabaseEngine) {
+		return fmt.Errorf("database_engine %q does not match the %s engine of restore_from database %q",
+			engine, source.DatabaseEngine, sourceID)
+	}
+	if version := d.Get("database_engine_version").(string); version != source.DatabaseEngineVersion {
+		return fmt.Errorf("database_engine_version %q does not match version %s of restore_from database %q",
+			version, source.DatabaseEngineVersion, sourceID)
+	}
+
+	if restoreType != "pitr" {
+		return nil
+	}
+
+	backups, _, err := client.govultrClient().Database.GetBackupInformation(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("error getting backups of restore_from database %q: %v", sourceID, err)
+	}
+
+	at, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return fmt.Errorf("restore_from.0.timestamp is not an RFC 3339 time: %v", err)
+	}
+	oldest, oldestErr := parseDatabaseBackupTime(backups.OldestBackup)
+	latest, latestErr := parseDatabaseBackupTime(backups.LatestBackup)
+	if oldestErr == nil && latestErr == nil && (at.Before(oldest) || at.After(latest)) {
+		return fmt.Errorf("restore_from.0.timestamp %s is outside of the backups of database %q, from %s to %s",
+			timestamp, sourceID, oldest.Format(time.RFC3339), latest.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func databasePlanSupportsEngine(plan *govultr.DatabasePlan, engine string) bool {
+	var supported *bool
+	switch strings.ToLower(engine) {
+	case "mysql":
+		supported = plan.SupportedEngines.MySQL
+	case "pg":
+		supported = plan.SupportedEngines.PG
+	case "valkey", "redis":
+		supported = plan.SupportedEngines.Valkey
+	case "kafka":
+		supported = plan.SupportedEngines.Kafka
+	default:
+		return true
+	}
+
+	return supported != nil && *supported
+}
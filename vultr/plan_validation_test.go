@@ -0,0 +1,184 @@
+package vultr
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceCustomizeDiffPlanValidation(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+	client := api.client(t)
+
+	// sold out everywhere except its original location
+	api.put("/v2/plans", "vhf-8c-32gb", map[string]interface{}{
+		"id": "vhf-8c-32gb", "vcpu_count": 8, "ram": 32768, "disk": 512, "type": "vhf", "locations": []interface{}{},
+	})
+	api.put("/v2/os", "1", map[string]interface{}{"id": 1, "name": "Legacy 32-bit", "arch": "i386", "family": "legacy"})
+
+	tests := []struct {
+		name     string
+		resource string
+		config   map[string]interface{}
+		wantErr  string
+	}{
+		{
+			name:     "instance plan in region",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vc2-1c-1gb", "region": "ams", "os_id": 1743},
+		},
+		{
+			name:     "instance region case",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vc2-1c-1gb", "region": "EWR", "os_id": 1743},
+		},
+		{
+			name:     "instance plan not in region",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vhf-2c-4gb", "region": "sea", "os_id": 1743},
+			wantErr:  `plan "vhf-2c-4gb" is not available in region "sea", it is offered in: ams, ewr`,
+		},
+		{
+			name:     "instance unknown plan",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vc2-99c", "region": "ewr", "os_id": 1743},
+			wantErr:  `plan "vc2-99c" does not exist`,
+		},
+		{
+			name:     "instance unknown os",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vc2-1c-1gb", "region": "ewr", "os_id": 4242},
+			wantErr:  "os_id 4242 does not exist",
+		},
+		{
+			name:     "instance gpu plan with 32-bit os",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vcg-a100-1c-6g-4vram", "region": "ewr", "os_id": 1},
+			wantErr:  `GPU plan "vcg-a100-1c-6g-4vram" requires a 64-bit operating system`,
+		},
+		{
+			name:     "instance from app plan not in region",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vhf-2c-4gb", "region": "sea", "app_id": 2},
+			wantErr:  `plan "vhf-2c-4gb" is not available in region "sea"`,
+		},
+		{
+			name:     "instance from snapshot unknown plan",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vc2-99c", "region": "ewr", "snapshot_id": "snap-1"},
+			wantErr:  `plan "vc2-99c" does not exist`,
+		},
+		{
+			name:     "instance from iso plan in region",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vc2-1c-1gb", "region": "ewr", "iso_id": "iso-1"},
+		},
+		{
+			name:     "instance plan without locations",
+			resource: "vultr_instance",
+			config:   map[string]interface{}{"plan": "vhf-8c-32gb", "region": "ewr", "os_id": 1743},
+			wantErr:  `plan "vhf-8c-32gb" is not available in any region`,
+		},
+		{
+			name:     "bare metal plan not in region",
+			resource: "vultr_bare_metal_server",
+			config:   map[string]interface{}{"plan": "vbm-4c-32gb", "region": "sea", "os_id": 1743},
+			wantErr:  `plan "vbm-4c-32gb" is not available in region "sea"`,
+		},
+		{
+			name:     "bare metal plan in region",
+			resource: "vultr_bare_metal_server",
+			config:   map[string]interface{}{"plan": "vbm-4c-32gb", "region": "ams", "os_id": 1743},
+		},
+		{
+			name:     "database engine not supported",
+			resource: "vultr_database",
+			config: map[string]interface{}{
+				"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "region": "ewr", "label": "db",
+				"database_engine": "kafka", "database_engine_version": "3.8",
+			},
+			wantErr: "does not support the kafka engine",
+		},
+		{
+			name:     "database plan not in region",
+			resource: "vultr_database",
+			config: map[string]interface{}{
+				"plan": "vultr-dbaas-hobbyist-cc-1-25-1", "region": "sea", "label": "db",
+				"database_engine": "pg", "database_engine_version": "16",
+			},
+			wantErr: `is not available in region "sea"`,
+		},
+		{
+			name:     "kubernetes node pool plan not in region",
+			resource: "vultr_kubernetes",
+			config: map[string]interface{}{
+				"region": "sea", "label": "vke", "version": "v1.30.0+1",
+				"node_pools": []interface{}{
+					map[string]interface{}{"plan": "vhf-2c-4gb", "label": "pool", "node_quantity": 1},
+				},
+			},
+			wantErr: `plan "vhf-2c-4gb" is not available in region "sea"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Provider().ResourcesMap[tt.resource]
+			_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.config), client)
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("expected error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("error %q does not contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInstanceCustomizeDiffNewOS(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	config := map[string]interface{}{"plan": "vc2-1c-1gb", "region": "ewr", "os_id": 1743, "label": "new-os"}
+	l := newMockLifecycle(t, api, "vultr_instance").apply(config)
+
+	// the plan of the instance sells out in its region
+	plan, _ := api.get("/v2/plans", "vc2-1c-1gb")
+	plan["locations"] = []interface{}{"ams"}
+
+	// os_id forces a replacement, which the SDK plans again as a new instance
+	l.withRawState(map[string]interface{}{"id": l.state.ID, "plan": "vc2-1c-1gb", "region": "ewr", "os_id": 1743})
+	diff := func(raw map[string]interface{}) error {
+		_, err := l.res.Diff(context.Background(), l.state, terraform.NewResourceConfigRaw(raw), l.meta)
+		return err
+	}
+
+	// a new os_id keeps the plan and region, so only the operating system is
+	// checked
+	config["os_id"] = 2136
+	if err := diff(config); err != nil {
+		t.Fatalf("unexpected error for a new os_id: %v", err)
+	}
+	config["os_id"] = 4242
+	if err := diff(config); err == nil || !strings.Contains(err.Error(), "os_id 4242 does not exist") {
+		t.Fatalf("expected an unknown os_id error, got %v", err)
+	}
+
+	// moving to another plan still checks the capacity of the region
+	config["os_id"] = 1743
+	config["plan"] = "vc2-2c-4gb"
+	if err := diff(config); err != nil {
+		t.Fatalf("unexpected error for an available plan: %v", err)
+	}
+	config["plan"] = "vc2-1c-1gb"
+	config["region"] = "sea"
+	if err := diff(config); err == nil {
+		t.Fatal("expected an error for a plan not available in the new region")
+	}
+}
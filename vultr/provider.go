@@ -2,6 +2,7 @@ package vultr
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider is the base Vultr terraform provider
@@ -14,6 +15,26 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VULTR_API_KEY", nil),
 				Description: "The API Key that allows interaction with the API",
 			},
+			"api_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VULTR_API_ENDPOINT", nil),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The base URL of the Vultr API. Defaults to the public Vultr API endpoint",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VULTR_CA_CERT_FILE", nil),
+				Description: "Path to a PEM encoded CA bundle trusted in addition to the system roots when connecting to the API",
+			},
+			"default_tags": defaultTagsSchema(),
+			"disable_catalog_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VULTR_DISABLE_CATALOG_CACHE", false),
+				Description: "Fetch plans, regions, operating systems and applications on every lookup instead of once per run",
+			},
 			"rate_limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -33,15 +54,23 @@ func Provider() *schema.Provider {
 			"vultr_bare_metal_plan":             dataSourceVultrBareMetalPlan(),
 			"vultr_bare_metal_server":           dataSourceVultrBareMetalServer(),
 			"vultr_block_storage":               dataSourceVultrBlockStorage(),
+			"vultr_block_storages":              dataSourceVultrBlockStorages(),
 			"vultr_container_registry":          dataSourceVultrContainerRegistry(),
 			"vultr_database":                    dataSourceVultrDatabase(),
+			"vultr_database_backups":            dataSourceVultrDatabaseBackups(),
+			"vultr_databases":                   dataSourceVultrDatabases(),
 			"vultr_dns_domain":                  dataSourceVultrDNSDomain(),
+			"vultr_dns_domains":                 dataSourceVultrDNSDomains(),
+			"vultr_dns_zone_file":               dataSourceVultrDNSZoneFile(),
 			"vultr_firewall_group":              dataSourceVultrFirewallGroup(),
 			"vultr_inference":                   dataSourceVultrInference(),
 			"vultr_iso_private":                 dataSourceVultrIsoPrivate(),
 			"vultr_iso_public":                  dataSourceVultrIsoPublic(),
 			"vultr_kubernetes":                  dataSourceVultrKubernetes(),
+			"vultr_kubernetes_clusters":         dataSourceVultrKubernetesClusters(),
 			"vultr_load_balancer":               dataSourceVultrLoadBalancer(),
+			"vultr_load_balancer_backends":      dataSourceVultrLoadBalancerBackends(),
+			"vultr_load_balancers":              dataSourceVultrLoadBalancers(),
 			"vultr_logs":                        dataSourceVultrLogs(),
 			"vultr_object_storage":              dataSourceVultrObjectStorage(),
 			"vultr_object_storage_cluster":      dataSourceVultrObjectStorageClusters(),
@@ -57,27 +86,36 @@ func Provider() *schema.Provider {
 			"vultr_plan":                        dataSourceVultrPlan(),
 			"vultr_region":                      dataSourceVultrRegion(),
 			"vultr_reserved_ip":                 dataSourceVultrReservedIP(),
+			"vultr_reserved_ips":                dataSourceVultrReservedIPs(),
 			"vultr_reverse_ipv4":                dataSourceVultrReverseIPV4(),
 			"vultr_reverse_ipv6":                dataSourceVultrReverseIPV6(),
 			"vultr_instance":                    dataSourceVultrInstance(),
 			"vultr_instances":                   dataSourceVultrInstances(),
 			"vultr_instance_ipv4":               dataSourceVultrInstanceIPV4(),
 			"vultr_snapshot":                    dataSourceVultrSnapshot(),
+			"vultr_snapshots":                   dataSourceVultrSnapshots(),
 			"vultr_ssh_key":                     dataSourceVultrSSHKey(),
+			"vultr_ssh_keys":                    dataSourceVultrSSHKeys(),
 			"vultr_startup_script":              dataSourceVultrStartupScript(),
 			"vultr_user":                        dataSourceVultrUser(),
 			"vultr_virtual_file_system_storage": dataSourceVultrVirtualFileSystemStorage(),
 			"vultr_vpc":                         dataSourceVultrVPC(),
+			"vultr_vpcs":                        dataSourceVultrVPCs(),
 			"vultr_vpc2":                        dataSourceVultrVPC2(),
+			"vultr_vpc2s":                       dataSourceVultrVPC2s(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"vultr_bare_metal_server":                    resourceVultrBareMetalServer(),
 			"vultr_block_storage":                        resourceVultrBlockStorage(),
+			"vultr_cdn_pull_zone":                        resourceVultrCDNPullZone(),
+			"vultr_cdn_push_zone":                        resourceVultrCDNPushZone(),
+			"vultr_cdn_push_zone_upload_endpoint":        resourceVultrCDNPushZoneUploadEndpoint(),
 			"vultr_container_registry":                   resourceVultrContainerRegistry(),
 			"vultr_database":                             resourceVultrDatabase(),
 			"vultr_database_connection_pool":             resourceVultrDatabaseConnectionPool(),
 			"vultr_database_db":                          resourceVultrDatabaseDB(),
+			"vultr_database_migration":                   resourceVultrDatabaseMigration(),
 			"vultr_database_replica":                     resourceVultrDatabaseReplica(),
 			"vultr_database_user":                        resourceVultrDatabaseUser(),
 			"vultr_database_topic":                       resourceVultrDatabaseTopic(),
@@ -85,13 +123,21 @@ func Provider() *schema.Provider {
 			"vultr_database_connector":                   resourceVultrDatabaseConnector(),
 			"vultr_dns_domain":                           resourceVultrDNSDomain(),
 			"vultr_dns_record":                           resourceVultrDNSRecord(),
+			"vultr_dns_zone_file":                        resourceVultrDNSZoneFile(),
+			"vultr_dns_zone_records":                     resourceVultrDNSZoneRecords(),
 			"vultr_firewall_group":                       resourceVultrFirewallGroup(),
+			"vultr_firewall_group_attachment":            resourceVultrFirewallGroupAttachment(),
+			"vultr_firewall_group_rules":                 resourceVultrFirewallGroupRules(),
 			"vultr_firewall_rule":                        resourceVultrFirewallRule(),
 			"vultr_inference":                            resourceVultrInference(),
 			"vultr_iso_private":                          resourceVultrIsoPrivate(),
 			"vultr_kubernetes":                           resourceVultrKubernetes(),
 			"vultr_kubernetes_node_pools":                resourceVultrKubernetesNodePools(),
 			"vultr_load_balancer":                        resourceVultrLoadBalancer(),
+			"vultr_load_balancer_attachment":             resourceVultrLoadBalancerAttachment(),
+			"vultr_load_balancer_firewall_rule":          resourceVultrLoadBalancerFirewallRule(),
+			"vultr_load_balancer_forwarding_rule":        resourceVultrLoadBalancerForwardingRule(),
+			"vultr_load_balancer_ssl":                    resourceVultrLoadBalancerSSL(),
 			"vultr_nat_gateway":                          resourceVultrNATGateway(),
 			"vultr_nat_gateway_firewall_rule":            resourceVultrNATGatewayFirewallRule(),
 			"vultr_nat_gateway_port_forwarding_rule":     resourceVultrNATGatewayPortForwardingRule(),
@@ -132,9 +178,14 @@ func Provider() *schema.Provider {
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		APIKey:     d.Get("api_key").(string),
-		RateLimit:  d.Get("rate_limit").(int),
-		RetryLimit: d.Get("retry_limit").(int),
+		APIKey:      d.Get("api_key").(string),
+		APIEndpoint: d.Get("api_endpoint").(string),
+		CACertFile:  d.Get("ca_cert_file").(string),
+		RateLimit:   d.Get("rate_limit").(int),
+		RetryLimit:  d.Get("retry_limit").(int),
+		DefaultTags: expandDefaultTags(d),
+
+		DisableCatalogCache: d.Get("disable_catalog_cache").(bool),
 	}
 
 	return config.Client()
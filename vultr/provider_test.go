@@ -2,7 +2,10 @@ package vultr
 
 import (
 	"context"
+	"encoding/pem"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,3 +48,67 @@ func skipCI(t *testing.T) {
 		t.Skip("Skipping testing in CI environment")
 	}
 }
+
+func TestProviderConfigureAPIEndpoint(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key":      mockAPIKey,
+		"api_endpoint": api.URL,
+	}))
+	if diags.HasError() {
+		t.Fatalf("error configuring provider: %v", diags)
+	}
+
+	regions, _, _, err := p.Meta().(*Client).govultrClient().Region.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("error listing regions from %s: %v", api.URL, err)
+	}
+	if len(regions) != 3 {
+		t.Fatalf("expected 3 regions from mock API, got %d", len(regions))
+	}
+}
+
+func TestProviderConfigureCACertFile(t *testing.T) {
+	t.Parallel()
+	api := newMockVultrAPI(t)
+	srv := httptest.NewTLSServer(api)
+	t.Cleanup(srv.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		caFile  string
+		wantErr bool
+	}{
+		{name: "trusted", caFile: caFile},
+		{name: "untrusted", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{APIKey: mockAPIKey, APIEndpoint: srv.URL, CACertFile: tt.caFile, RetryLimit: 1}
+			client, err := config.Client()
+			if err != nil {
+				t.Fatalf("error configuring client: %v", err)
+			}
+
+			_, _, _, err = client.govultrClient().Region.List(context.Background(), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Region.List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	missing := Config{APIKey: mockAPIKey, CACertFile: filepath.Join(t.TempDir(), "missing.pem")}
+	if _, err := missing.Client(); err == nil {
+		t.Fatal("expected error for missing CA certificate file")
+	}
+}
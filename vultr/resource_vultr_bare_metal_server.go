@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +23,7 @@ func resourceVultrBareMetalServer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrBareMetalServerCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -50,6 +50,7 @@ func resourceVultrBareMetalServer() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Default:  nil,
 			},
+			"tags_all": tagsAllSchema(),
 			"script_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -268,6 +269,7 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 			req.Tags = append(req.Tags, v.(string))
 		}
 	}
+	req.Tags = meta.(*Client).mergeDefaultTags(req.Tags)
 
 	if vpc2IDs, vpc2OK := d.GetOk("vpc2_ids"); vpc2OK {
 		for _, v := range vpc2IDs.(*schema.Set).List() {
@@ -279,7 +281,7 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 
 	bm, _, err := client.BareMetalServer.Create(ctx, req)
 	if err != nil {
-		return diag.Errorf("error creating bare metal server: %v", err)
+		return apiErrorDiag(err, resourceVultrBareMetalServer().Schema, "error creating bare metal server")
 	}
 
 	d.SetId(bm.ID)
@@ -293,7 +295,7 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 		Refresh: func() (interface{}, string, error) {
 			bmRefresh, _, err := client.BareMetalServer.Get(ctx, d.Id())
 			if err != nil {
-				if strings.Contains(err.Error(), "Not found.") {
+				if isNotFoundError(err) {
 					return nil, "", nil
 				}
 
@@ -328,12 +330,7 @@ func resourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceDat
 
 	bms, _, err := client.BareMetalServer.Get(ctx, d.Id())
 	if err != nil {
-		missing, missErr := checkIsMissing(err, "invalid server")
-		if missErr != nil {
-			return diag.Errorf("error in api response %q : %v", err, missErr)
-		}
-
-		if missing {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing bare metal server (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -382,9 +379,12 @@ func resourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceDat
 	if err := d.Set("label", bms.Label); err != nil {
 		return diag.Errorf("unable to set resource bare_metal_server `label` read value: %v", err)
 	}
-	if err := d.Set("tags", bms.Tags); err != nil {
+	if err := d.Set("tags", meta.(*Client).resourceTags(d, bms.Tags)); err != nil {
 		return diag.Errorf("unable to set resource bare_metal_server `tags` read value: %v", err)
 	}
+	if err := d.Set("tags_all", bms.Tags); err != nil {
+		return diag.Errorf("unable to set resource bare_metal_server `tags_all` read value: %v", err)
+	}
 	if err := d.Set("mac_address", bms.MacAddress); err != nil {
 		return diag.Errorf("unable to set resource bare_metal_server `mac_address` read value: %v", err)
 	}
@@ -531,9 +531,9 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 		req.DetachVPC2 = append(req.DetachVPC2, diffSlice(newIDs, oldIDs)...) //nolint:staticcheck
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		_, newTags := tfChangeToSlices("tags", d)
-		req.Tags = newTags
+		req.Tags = meta.(*Client).mergeDefaultTags(newTags)
 	}
 
 	if d.HasChange("user_scheme") {
@@ -543,7 +543,7 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if _, _, err := client.BareMetalServer.Update(ctx, d.Id(), req); err != nil {
-		return diag.Errorf("error updating bare metal %s : %s", d.Id(), err.Error())
+		return apiErrorDiag(err, resourceVultrBareMetalServer().Schema, "error updating bare metal %s", d.Id())
 	}
 
 	return resourceVultrBareMetalServerRead(ctx, d, meta)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -174,7 +173,7 @@ func resourceVultrBlockStorageRead(ctx context.Context, d *schema.ResourceData,
 
 	bs, _, err := client.BlockStorage.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid block storage ID") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing block storage (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
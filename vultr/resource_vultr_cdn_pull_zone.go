@@ -0,0 +1,250 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrCDNPullZone() *schema.Resource {
+	zoneSchema := cdnZoneSchema()
+	zoneSchema["origin_scheme"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+	}
+	zoneSchema["origin_domain"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	zoneSchema["last_purge"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceVultrCDNPullZoneCreate,
+		ReadContext:   resourceVultrCDNPullZoneRead,
+		UpdateContext: resourceVultrCDNPullZoneUpdate,
+		DeleteContext: resourceVultrCDNPullZoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: zoneSchema,
+	}
+}
+
+func resourceVultrCDNPullZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	zone, _, err := client.CDN.CreatePullZone(ctx, expandCDNZoneReq(d))
+	if err != nil {
+		return diag.Errorf("error creating CDN pull zone: %v", err)
+	}
+
+	d.SetId(zone.ID)
+	log.Printf("[INFO] Created CDN pull zone with ID: %s", d.Id())
+
+	return resourceVultrCDNPullZoneRead(ctx, d, meta)
+}
+
+func resourceVultrCDNPullZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	zone, _, err := client.CDN.GetPullZone(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] CDN pull zone (%s) not found and will be removed", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting CDN pull zone: %v", err)
+	}
+
+	if err := setCDNZone(d, zone); err != nil {
+		return diag.Errorf("unable to set resource cdn_pull_zone read value: %v", err)
+	}
+	if err := d.Set("origin_scheme", zone.OriginScheme); err != nil {
+		return diag.Errorf("unable to set resource cdn_pull_zone `origin_scheme` read value: %v", err)
+	}
+	if err := d.Set("origin_domain", zone.OriginDomain); err != nil {
+		return diag.Errorf("unable to set resource cdn_pull_zone `origin_domain` read value: %v", err)
+	}
+	if err := d.Set("last_purge", zone.DatePurged); err != nil {
+		return diag.Errorf("unable to set resource cdn_pull_zone `last_purge` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrCDNPullZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	if _, _, err := client.CDN.UpdatePullZone(ctx, d.Id(), expandCDNZoneReq(d)); err != nil {
+		return diag.Errorf("error updating CDN pull zone %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrCDNPullZoneRead(ctx, d, meta)
+}
+
+func resourceVultrCDNPullZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Deleting CDN pull zone %s", d.Id())
+
+	if err := client.CDN.DeletePullZone(ctx, d.Id()); err != nil {
+		return diag.Errorf("error deleting CDN pull zone %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// cdnZoneSchema returns the attributes shared by pull and push zones
+func cdnZoneSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"vanity_domain": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		// the API never returns the certificate key, so both are kept as
+		// configured rather than read back
+		"ssl_cert": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"vanity_domain", "ssl_cert_key"},
+		},
+		"ssl_cert_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"vanity_domain", "ssl_cert"},
+		},
+		"cors": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"gzip": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"block_ai": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"block_bad_bots": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"regions": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"date_created": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cdn_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cache_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"requests": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"in_bytes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"out_bytes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"packets_per_sec": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func expandCDNZoneReq(d *schema.ResourceData) *govultr.CDNZoneReq {
+	req := &govultr.CDNZoneReq{
+		Label:        d.Get("label").(string),
+		VanityDomain: d.Get("vanity_domain").(string),
+		SSLCert:      d.Get("ssl_cert").(string),
+		SSLCertKey:   d.Get("ssl_cert_key").(string),
+		CORS:         govultr.BoolToBoolPtr(d.Get("cors").(bool)),
+		GZIP:         govultr.BoolToBoolPtr(d.Get("gzip").(bool)),
+		BlockAI:      govultr.BoolToBoolPtr(d.Get("block_ai").(bool)),
+		BlockBadBots: govultr.BoolToBoolPtr(d.Get("block_bad_bots").(bool)),
+	}
+
+	if v, ok := d.GetOk("origin_scheme"); ok {
+		req.OriginScheme = v.(string)
+	}
+	if v, ok := d.GetOk("origin_domain"); ok {
+		req.OriginDomain = v.(string)
+	}
+
+	if regions, ok := d.GetOk("regions"); ok {
+		for _, r := range regions.(*schema.Set).List() {
+			req.Regions = append(req.Regions, r.(string))
+		}
+	}
+
+	return req
+}
+
+// setCDNZone sets the attributes shared by pull and push zones
+func setCDNZone(d *schema.ResourceData, zone *govultr.CDNZone) error {
+	values := map[string]interface{}{
+		"label":           zone.Label,
+		"vanity_domain":   zone.VanityDomain,
+		"cors":            zone.CORS,
+		"gzip":            zone.GZIP,
+		"block_ai":        zone.BlockAI,
+		"block_bad_bots":  zone.BlockBadBots,
+		"regions":         zone.Regions,
+		"date_created":    zone.DateCreated,
+		"status":          zone.Status,
+		"cdn_url":         zone.CDNURL,
+		"cache_size":      zone.CacheSize,
+		"requests":        zone.Requests,
+		"in_bytes":        zone.BytesIn,
+		"out_bytes":       zone.BytesOut,
+		"packets_per_sec": zone.PacketsPerSec,
+	}
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("`%s`: %v", k, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrCDNPullZoneBasic(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-cdn")
+	name := "vultr_cdn_pull_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrCDNPullZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrCDNPullZoneConfig(rLabel, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", rLabel),
+					resource.TestCheckResourceAttr(name, "origin_scheme", "https"),
+					resource.TestCheckResourceAttr(name, "gzip", "false"),
+					resource.TestCheckResourceAttrSet(name, "cdn_url"),
+				),
+			},
+			{
+				Config: testAccVultrCDNPullZoneConfig(rLabel, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "gzip", "true"),
+					resource.TestCheckResourceAttr(name, "block_bad_bots", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVultrCDNPullZoneDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_cdn_pull_zone" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		if _, _, err := client.CDN.GetPullZone(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("CDN pull zone still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccVultrCDNPullZoneConfig(label string, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "vultr_cdn_pull_zone" "test" {
+			label          = "%s"
+			origin_scheme  = "https"
+			origin_domain  = "www.vultr.com"
+			gzip           = %t
+			block_bad_bots = %t
+		}`, label, enabled, enabled)
+}
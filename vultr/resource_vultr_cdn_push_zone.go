@@ -0,0 +1,137 @@
+package vultr
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrCDNPushZone() *schema.Resource {
+	zoneSchema := cdnZoneSchema()
+	zoneSchema["file_count"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	zoneSchema["total_size"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	zoneSchema["files"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"last_modified": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceVultrCDNPushZoneCreate,
+		ReadContext:   resourceVultrCDNPushZoneRead,
+		UpdateContext: resourceVultrCDNPushZoneUpdate,
+		DeleteContext: resourceVultrCDNPushZoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: zoneSchema,
+	}
+}
+
+func resourceVultrCDNPushZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	zone, _, err := client.CDN.CreatePushZone(ctx, expandCDNZoneReq(d))
+	if err != nil {
+		return diag.Errorf("error creating CDN push zone: %v", err)
+	}
+
+	d.SetId(zone.ID)
+	log.Printf("[INFO] Created CDN push zone with ID: %s", d.Id())
+
+	return resourceVultrCDNPushZoneRead(ctx, d, meta)
+}
+
+func resourceVultrCDNPushZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	zone, _, err := client.CDN.GetPushZone(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] CDN push zone (%s) not found and will be removed", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting CDN push zone: %v", err)
+	}
+
+	if err := setCDNZone(d, zone); err != nil {
+		return diag.Errorf("unable to set resource cdn_push_zone read value: %v", err)
+	}
+
+	files, _, err := client.CDN.ListPushZoneFiles(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error getting CDN push zone %s files: %v", d.Id(), err)
+	}
+
+	if err := d.Set("file_count", files.Count); err != nil {
+		return diag.Errorf("unable to set resource cdn_push_zone `file_count` read value: %v", err)
+	}
+	if err := d.Set("total_size", files.Size); err != nil {
+		return diag.Errorf("unable to set resource cdn_push_zone `total_size` read value: %v", err)
+	}
+	if err := d.Set("files", flattenCDNZoneFiles(files.Files)); err != nil {
+		return diag.Errorf("unable to set resource cdn_push_zone `files` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrCDNPushZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	if _, _, err := client.CDN.UpdatePushZone(ctx, d.Id(), expandCDNZoneReq(d)); err != nil {
+		return diag.Errorf("error updating CDN push zone %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrCDNPushZoneRead(ctx, d, meta)
+}
+
+func resourceVultrCDNPushZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Deleting CDN push zone %s", d.Id())
+
+	if err := client.CDN.DeletePushZone(ctx, d.Id()); err != nil {
+		return diag.Errorf("error deleting CDN push zone %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenCDNZoneFiles(files []govultr.CDNZoneFile) []map[string]interface{} {
+	var fileList []map[string]interface{}
+	for i := range files {
+		fileList = append(fileList, map[string]interface{}{
+			"name":          files[i].Name,
+			"size":          files[i].Size,
+			"last_modified": files[i].DateModified,
+		})
+	}
+
+	return fileList
+}
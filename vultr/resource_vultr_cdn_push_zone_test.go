@@ -0,0 +1,67 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrCDNPushZoneBasic(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-cdn")
+	name := "vultr_cdn_push_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrCDNPushZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrCDNPushZoneConfig(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", rLabel),
+					resource.TestCheckResourceAttr(name, "cors", "true"),
+					resource.TestCheckResourceAttrSet(name, "cdn_url"),
+					resource.TestCheckResourceAttrSet("vultr_cdn_push_zone_upload_endpoint.test", "url"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVultrCDNPushZoneDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_cdn_push_zone" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		if _, _, err := client.CDN.GetPushZone(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("CDN push zone still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccVultrCDNPushZoneConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_cdn_push_zone" "test" {
+			label = "%s"
+			cors  = true
+		}
+
+		resource "vultr_cdn_push_zone_upload_endpoint" "test" {
+			zone_id = vultr_cdn_push_zone.test.id
+			name    = "index.html"
+			size    = 1024
+		}`, label)
+}
@@ -0,0 +1,107 @@
+package vultr
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrCDNPushZoneUploadEndpoint requests a presigned form upload for
+// a single file in a push zone. The upload itself happens outside terraform,
+// by posting the inputs and the file to the url.
+func resourceVultrCDNPushZoneUploadEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrCDNPushZoneUploadEndpointCreate,
+		ReadContext:   resourceVultrCDNPushZoneUploadEndpointRead,
+		DeleteContext: resourceVultrCDNPushZoneUploadEndpointDelete,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVultrCDNPushZoneUploadEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	zoneID := d.Get("zone_id").(string)
+	req := &govultr.CDNZoneEndpointReq{
+		Name: d.Get("name").(string),
+		Size: d.Get("size").(int),
+	}
+
+	endpoint, _, err := client.CDN.CreatePushZoneFileEndpoint(ctx, zoneID, req)
+	if err != nil {
+		return diag.Errorf("error creating CDN push zone %s upload endpoint: %v", zoneID, err)
+	}
+
+	d.SetId(req.Name)
+	log.Printf("[INFO] Created CDN push zone %s upload endpoint for %s", zoneID, d.Id())
+
+	if err := d.Set("url", endpoint.URL); err != nil {
+		return diag.Errorf("unable to set resource cdn_push_zone_upload_endpoint `url` read value: %v", err)
+	}
+	if err := d.Set("inputs", map[string]interface{}{
+		"acl":              endpoint.Inputs.ACL,
+		"key":              endpoint.Inputs.Key,
+		"policy":           endpoint.Inputs.Policy,
+		"x-amz-credential": endpoint.Inputs.Credential,
+		"x-amz-algorithm":  endpoint.Inputs.Algorithm,
+		"x-amz-signature":  endpoint.Inputs.Signature,
+	}); err != nil {
+		return diag.Errorf("unable to set resource cdn_push_zone_upload_endpoint `inputs` read value: %v", err)
+	}
+
+	return resourceVultrCDNPushZoneUploadEndpointRead(ctx, d, meta)
+}
+
+func resourceVultrCDNPushZoneUploadEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	// the endpoint itself can't be read back, only the zone it belongs to
+	if _, _, err := client.CDN.GetPushZone(ctx, d.Get("zone_id").(string)); err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] CDN push zone (%s) not found, upload endpoint will be removed", d.Get("zone_id"))
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting CDN push zone: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrCDNPushZoneUploadEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	log.Printf("[INFO] Removing CDN push zone %s upload endpoint for %s from state", d.Get("zone_id"), d.Id())
+
+	return nil
+}
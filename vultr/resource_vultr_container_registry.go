@@ -6,7 +6,6 @@ import (
 	"log"
 	"regexp"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -101,7 +100,7 @@ func resourceVultrContainerRegistryRead(ctx context.Context, d *schema.ResourceD
 
 	cr, _, err := client.ContainerRegistry.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid container registry ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Container registry (%s) not found and will be removed", d.Id())
 			d.SetId("")
 			return nil
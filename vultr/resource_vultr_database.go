@@ -2,6 +2,7 @@ package vultr
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -23,6 +24,7 @@ func resourceVultrDatabase() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrDatabaseCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -35,6 +37,14 @@ func resourceVultrDatabase() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"upgrade_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upgrade_previous_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"region": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -121,6 +131,17 @@ func resourceVultrDatabase() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"advanced_options": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressDatabaseAdvancedOptionDiff,
+			},
+			"restart_required_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"password": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -217,6 +238,35 @@ func resourceVultrDatabase() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"restore_from": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "backup",
+							ValidateFunc: validation.StringInSlice([]string{"backup", "pitr"}, false),
+						},
+						"timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
 			"latest_backup": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -304,10 +354,25 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	log.Printf("[INFO] Creating database")
-	database, _, err := client.Database.Create(ctx, req)
-	if err != nil {
-		return diag.Errorf("error creating database: %v", err)
+	var database *govultr.Database
+	var err error
+	restore, restoring := d.GetOk("restore_from.0")
+	if restoring {
+		sourceID, forkReq, errRestore := expandDatabaseRestore(restore.(map[string]interface{}), req)
+		if errRestore != nil {
+			return diag.FromErr(errRestore)
+		}
+		log.Printf("[INFO] Restoring database from %s", sourceID)
+		database, _, err = client.Database.Fork(ctx, sourceID, forkReq)
+		if err != nil {
+			return apiErrorDiag(err, resourceVultrDatabase().Schema, "error restoring database from %s", sourceID)
+		}
+	} else {
+		log.Printf("[INFO] Creating database")
+		database, _, err = client.Database.Create(ctx, req)
+		if err != nil {
+			return apiErrorDiag(err, resourceVultrDatabase().Schema, "error creating database")
+		}
 	}
 
 	d.SetId(database.ID)
@@ -317,8 +382,12 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("error while waiting for Managed Database %s to be in an active state : %s", d.Id(), err)
 	}
 
-	// Some values can only be properly set after creation
+	// Some values can only be properly set after creation, and a restored
+	// database takes everything but its label, region and plan from the source
 	req2 := &govultr.DatabaseUpdateReq{}
+	if restoring {
+		req2 = databaseRestoreUpdateReq(req)
+	}
 	if clusterTimeZone, clusterTimeZoneOK := d.GetOk("cluster_time_zone"); clusterTimeZoneOK {
 		log.Printf("[INFO] Updating database default time zone")
 		req2.ClusterTimeZone = clusterTimeZone.(string)
@@ -332,9 +401,17 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Perform an update if needed
-	if req2.ClusterTimeZone != "" || req2.BackupHour != nil || req2.BackupMinute != nil {
+	if restoring || req2.ClusterTimeZone != "" || req2.BackupHour != nil || req2.BackupMinute != nil {
 		if _, _, err := client.Database.Update(ctx, d.Id(), req2); err != nil {
-			return diag.Errorf("error updating post-creation values for database: %v", err)
+			return apiErrorDiag(err, resourceVultrDatabase().Schema, "error updating post-creation values for database")
+		}
+	}
+
+	// Advanced options can only be checked against the options of the engine
+	// once the database exists
+	if options, ok := d.GetOk("advanced_options"); ok {
+		if err := applyDatabaseAdvancedOptions(ctx, client, d, options.(map[string]interface{}), nil); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -358,7 +435,7 @@ func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta
 
 	database, _, err := client.Database.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid database ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing database (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -406,7 +483,28 @@ func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to set resource database `database_engine` read value: %v", err)
 	}
 
-	if err := d.Set("database_engine_version", database.DatabaseEngineVersion); err != nil {
+	// an upgrade the apply stopped waiting for is settled once the database
+	// runs again, until then the target version is kept so that the next plan
+	// doesn't start another upgrade
+	databaseEngineVersion := database.DatabaseEngineVersion
+	if d.Get("upgrade_status").(string) == "in_progress" {
+		targetVersion := d.Get("database_engine_version").(string)
+		upgradeStatus := "in_progress"
+		switch {
+		case database.Status != "Running":
+			databaseEngineVersion = targetVersion
+		case databaseEngineVersion == targetVersion:
+			upgradeStatus = "succeeded"
+		default:
+			upgradeStatus = "failed"
+		}
+
+		if err := d.Set("upgrade_status", upgradeStatus); err != nil {
+			return diag.Errorf("unable to set resource database `upgrade_status` read value: %v", err)
+		}
+	}
+
+	if err := d.Set("database_engine_version", databaseEngineVersion); err != nil {
 		return diag.Errorf("unable to set resource database `database_engine_version` read value: %v", err)
 	}
 
@@ -562,6 +660,27 @@ func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to set resource database `read_replicas` read value: %v", err)
 	}
 
+	// only the options managed by the configuration are read back
+	if managed := d.Get("advanced_options").(map[string]interface{}); len(managed) != 0 {
+		options, err := getDatabaseAdvancedOptions(ctx, client, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting advanced options of database (%s): %v", d.Id(), err)
+		}
+
+		names := make([]string, 0, len(managed))
+		for name := range managed {
+			names = append(names, name)
+		}
+		if err := d.Set("advanced_options", flattenDatabaseAdvancedOptions(options.ConfiguredOptions, names)); err != nil {
+			return diag.Errorf("unable to set resource database `advanced_options` read value: %v", err)
+		}
+	}
+
+	restart := databaseRestartRequired(database.DatabaseEngine, d.Get("advanced_options").(map[string]interface{}))
+	if err := d.Set("restart_required_options", restart); err != nil {
+		return diag.Errorf("unable to set resource database `restart_required_options` read value: %v", err)
+	}
+
 	return nil
 }
 func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -708,7 +827,7 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if _, _, err := client.Database.Update(ctx, d.Id(), req); err != nil {
-		return diag.Errorf("error updating database %s : %s", d.Id(), err.Error())
+		return apiErrorDiag(err, resourceVultrDatabase().Schema, "error updating database %s", d.Id())
 	}
 
 	if d.HasChange("region") || d.HasChange("plan") || d.HasChange("vpc_id") {
@@ -737,38 +856,37 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	// Version changes have their own API protocol/checks
-	if d.HasChange("database_engine_version") {
-		// Check available versions against input
-		log.Printf("[INFO] Checking available version upgrades")
-		availableVersions, _, err := client.Database.ListAvailableVersions(ctx, d.Id())
-		if err != nil {
-			return diag.Errorf("error checking available version upgrades %s : %s", d.Id(), err.Error())
-		}
-		_, newVal := d.GetChange("database_engine_version")
-		databaseEngineVersion := newVal.(string)
-		if !versionCompare(availableVersions, databaseEngineVersion) {
-			return diag.Errorf("invalid version %s provided for database %s", databaseEngineVersion, d.Id())
+	if d.HasChange("advanced_options") {
+		oldVal, newVal := d.GetChange("advanced_options")
+		oldOptions := oldVal.(map[string]interface{})
+
+		changed := map[string]interface{}{}
+		for name, value := range newVal.(map[string]interface{}) {
+			old, ok := oldOptions[name]
+			if !ok || old != value && !suppressDatabaseAdvancedOptionDiff(name, old.(string), value.(string), d) {
+				changed[name] = value
+			}
 		}
 
-		// Start version upgrade
-		log.Printf("[INFO] Initiating version upgrade")
-		req2 := &govultr.DatabaseVersionUpgradeReq{
-			Version: databaseEngineVersion,
+		// removed options are reset to the engine default
+		var removed []string
+		for name := range oldOptions {
+			if _, ok := newVal.(map[string]interface{})[name]; !ok {
+				removed = append(removed, name)
+			}
 		}
-		if _, _, err := client.Database.StartVersionUpgrade(ctx, d.Id(), req2); err != nil {
-			return diag.Errorf("error upgrading database version %s : %s", d.Id(), err.Error())
+
+		if len(changed) != 0 || len(removed) != 0 {
+			if err := applyDatabaseAdvancedOptions(ctx, client, d, changed, removed); err != nil {
+				return diag.FromErr(err)
+			}
 		}
+	}
 
-		// Wait for running state
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
-		if errAvail != nil {
-			return diag.Errorf(
-				"error while waiting for Managed Database %s to be in an active state : %s",
-				d.Id(),
-				errAvail,
-			)
+	// Version changes have their own API protocol/checks
+	if d.HasChange("database_engine_version") {
+		if diags := resourceVultrDatabaseUpgrade(ctx, d, meta); diags.HasError() {
+			return diags
 		}
 	}
 
@@ -804,6 +922,55 @@ func waitForDatabaseAvailable(ctx context.Context, d *schema.ResourceData, targe
 	return stateConf.WaitForStateContext(ctx)
 }
 
+// errDatabaseUpgradeRolledBack is returned when the database runs another
+// version than the upgrade target after having left the running state
+var errDatabaseUpgradeRolledBack = errors.New("database upgrade was rolled back")
+
+// waitForDatabaseUpgrade waits for a version upgrade to finish. The API
+// doesn't report upgrades, so the database is upgraded once it runs the new
+// version, and the upgrade was rolled back when it runs another version again
+// after having left the running state. An error status is passed through while
+// the upgrade is running.
+func waitForDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, version string, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for Managed Database (%s) to be upgraded to version %s", d.Id(), version)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{"Pending", "Rebalancing", "Rebuilding", "Configuring", "Error"},
+		Target:         []string{"Upgraded"},
+		Refresh:        newDatabaseUpgradeStateRefresh(ctx, d, version, meta),
+		Timeout:        d.Timeout(schema.TimeoutUpdate),
+		Delay:          10 * time.Second,
+		MinTimeout:     3 * time.Second,
+		NotFoundChecks: 60,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func newDatabaseUpgradeStateRefresh(ctx context.Context, d *schema.ResourceData, version string, meta interface{}) retry.StateRefreshFunc { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	started := false
+	return func() (interface{}, string, error) {
+		database, _, err := client.Database.Get(ctx, d.Id())
+		if err != nil {
+			return nil, "", fmt.Errorf("error retrieving Managed Database %s : %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] The Managed Database Status is %s on version %s", database.Status, database.DatabaseEngineVersion)
+		switch {
+		case database.Status != "Running":
+			started = true
+			return database, database.Status, nil
+		case database.DatabaseEngineVersion == version:
+			return database, "Upgraded", nil
+		case started:
+			return database, "Failed", fmt.Errorf("%w : database is running version %s", errDatabaseUpgradeRolledBack, database.DatabaseEngineVersion) //nolint:lll
+		default:
+			return database, "Pending", nil
+		}
+	}
+}
+
 func newDatabaseStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
 	client := meta.(*Client).govultrClient()
 	return func() (interface{}, string, error) {
@@ -823,6 +990,100 @@ func newDatabaseStateRefresh(ctx context.Context, d *schema.ResourceData, meta i
 	}
 }
 
+// resourceVultrDatabaseUpgrade upgrades the engine version of a database and
+// waits for the upgrade to finish. The outcome and the version upgraded from
+// are kept in state. A rolled back upgrade fails the apply with the actual
+// version in state, while an upgrade that is still running when the wait ends
+// is kept in progress with the target version until a later refresh settles it.
+func resourceVultrDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+	oldVal, newVal := d.GetChange("database_engine_version")
+	previousVersion, databaseEngineVersion := oldVal.(string), newVal.(string)
+
+	// Check available versions against input
+	log.Printf("[INFO] Checking available version upgrades")
+	availableVersions, _, err := client.Database.ListAvailableVersions(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error checking available version upgrades %s : %s", d.Id(), err.Error())
+	}
+	if !versionCompare(availableVersions, databaseEngineVersion) {
+		return diag.Errorf("invalid version %s provided for database %s", databaseEngineVersion, d.Id())
+	}
+
+	// Start version upgrade
+	log.Printf("[INFO] Initiating version upgrade from %s to %s", previousVersion, databaseEngineVersion)
+	req := &govultr.DatabaseVersionUpgradeReq{
+		Version: databaseEngineVersion,
+	}
+	if _, _, err := client.Database.StartVersionUpgrade(ctx, d.Id(), req); err != nil {
+		return apiErrorDiag(err, resourceVultrDatabase().Schema, "error upgrading database version %s", d.Id())
+	}
+
+	if err := d.Set("upgrade_previous_version", previousVersion); err != nil {
+		return diag.Errorf("unable to set resource database `upgrade_previous_version` read value: %v", err)
+	}
+
+	upgradeStatus := "succeeded"
+	_, errWait := waitForDatabaseUpgrade(ctx, d, databaseEngineVersion, meta)
+	switch {
+	case errors.Is(errWait, errDatabaseUpgradeRolledBack):
+		upgradeStatus = "failed"
+	case errWait != nil:
+		upgradeStatus = "in_progress"
+	}
+
+	if err := d.Set("upgrade_status", upgradeStatus); err != nil {
+		return diag.Errorf("unable to set resource database `upgrade_status` read value: %v", err)
+	}
+	if errWait == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	if upgradeStatus == "failed" {
+		// keep the version the database is actually on, so the next plan retries
+		diags = resourceVultrDatabaseRead(ctx, d, meta)
+	}
+	return append(diags, diag.Errorf(
+		"error upgrading Managed Database %s from version %s to %s : %s",
+		d.Id(),
+		previousVersion,
+		databaseEngineVersion,
+		errWait,
+	)...)
+}
+
+// applyDatabaseAdvancedOptions validates the options against the options of
+// the database engine and updates them. The removed options are sent as null,
+// which resets them to the engine default.
+func applyDatabaseAdvancedOptions(ctx context.Context, client *govultr.Client, d *schema.ResourceData, raw map[string]interface{}, removed []string) error { //nolint:lll
+	engine := d.Get("database_engine").(string)
+
+	current, err := getDatabaseAdvancedOptions(ctx, client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting advanced options of database %s : %v", d.Id(), err)
+	}
+
+	options, err := expandDatabaseAdvancedOptions(engine, raw, current.AvailableOptions)
+	if err != nil {
+		return err
+	}
+	for _, name := range removed {
+		options[name] = nil
+	}
+
+	log.Printf("[INFO] Updating advanced options of database %s", d.Id())
+	if _, err := updateDatabaseAdvancedOptions(ctx, client, d.Id(), options); err != nil {
+		return fmt.Errorf("error updating advanced options of database %s : %v", d.Id(), err)
+	}
+
+	if restart := databaseRestartRequired(engine, options); len(restart) != 0 {
+		log.Printf("[INFO] Advanced options %s of database %s apply after a restart", strings.Join(restart, ", "), d.Id())
+	}
+
+	return nil
+}
+
 func versionCompare(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -831,3 +1092,49 @@ func versionCompare(s []string, e string) bool {
 	}
 	return false
 }
+
+// expandDatabaseRestore returns the source database and the fork request of
+// a restore_from block. A backup restore uses the latest backup of the source.
+func expandDatabaseRestore(restore map[string]interface{}, req *govultr.DatabaseCreateReq) (string, *govultr.DatabaseForkReq, error) { //nolint:lll
+	forkReq := &govultr.DatabaseForkReq{
+		Label:  req.Label,
+		Region: req.Region,
+		Plan:   req.Plan,
+		Type:   "basebackup",
+	}
+
+	if restore["type"].(string) == "pitr" {
+		forkReq.Type = "pitr"
+		at, err := time.Parse(time.RFC3339, restore["timestamp"].(string))
+		if err != nil {
+			return "", nil, fmt.Errorf("restore_from.0.timestamp is not an RFC 3339 time: %v", err)
+		}
+		forkReq.Date = at.UTC().Format("2006-01-02")
+		forkReq.Time = at.UTC().Format("15:04:05")
+	}
+
+	return restore["database_id"].(string), forkReq, nil
+}
+
+// databaseRestoreUpdateReq carries the settings of a create request that a
+// fork doesn't take over to the update that follows it
+func databaseRestoreUpdateReq(req *govultr.DatabaseCreateReq) *govultr.DatabaseUpdateReq {
+	updateReq := &govultr.DatabaseUpdateReq{
+		Tag:                    req.Tag,
+		MaintenanceDOW:         req.MaintenanceDOW,
+		MaintenanceTime:        req.MaintenanceTime,
+		BackupHour:             req.BackupHour,
+		BackupMinute:           req.BackupMinute,
+		TrustedIPs:             req.TrustedIPs,
+		MySQLSQLModes:          req.MySQLSQLModes,
+		MySQLRequirePrimaryKey: req.MySQLRequirePrimaryKey,
+		MySQLSlowQueryLog:      req.MySQLSlowQueryLog,
+		MySQLLongQueryTime:     req.MySQLLongQueryTime,
+		EvictionPolicy:         req.EvictionPolicy,
+	}
+	if req.VPCID != "" {
+		updateReq.VPCID = govultr.StringToStringPtr(req.VPCID)
+	}
+
+	return updateReq
+}
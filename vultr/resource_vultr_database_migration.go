@@ -0,0 +1,249 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrDatabaseMigration runs an online migration from an external
+// database into a managed database. A migration can't be changed while it
+// runs, so every argument forces a new one, and destroying the resource
+// detaches the managed database from its source.
+func resourceVultrDatabaseMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrDatabaseMigrationCreate,
+		ReadContext:   resourceVultrDatabaseMigrationRead,
+		DeleteContext: resourceVultrDatabaseMigrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrDatabaseMigrationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ignored_databases": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+func resourceVultrDatabaseMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+
+	var ignored []string
+	for _, v := range d.Get("ignored_databases").([]interface{}) {
+		ignored = append(ignored, v.(string))
+	}
+
+	req := &govultr.DatabaseMigrationStartReq{
+		Host:             d.Get("host").(string),
+		Port:             d.Get("port").(int),
+		Username:         d.Get("username").(string),
+		Password:         d.Get("password").(string),
+		Database:         d.Get("database").(string),
+		IgnoredDatabases: strings.Join(ignored, ","),
+		SSL:              govultr.BoolToBoolPtr(d.Get("ssl").(bool)),
+	}
+
+	log.Printf("[INFO] Starting migration of database %s from %s", databaseID, req.Host)
+	if _, _, err := client.Database.StartMigration(ctx, databaseID, req); err != nil {
+		return diag.Errorf("error starting migration of database %s : %v", databaseID, err)
+	}
+
+	d.SetId(databaseID)
+
+	if _, err := waitForDatabaseMigration(ctx, d, meta); err != nil {
+		return diag.Errorf("error while waiting for migration of database %s to finish : %s", databaseID, err)
+	}
+
+	return resourceVultrDatabaseMigrationRead(ctx, d, meta)
+}
+
+func resourceVultrDatabaseMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	migration, _, err := client.Database.GetMigrationStatus(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Migration of database %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting migration of database %s : %v", d.Id(), err)
+	}
+	if migration == nil {
+		log.Printf("[WARN] Database %s has no migration, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("database_id", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource database_migration `database_id` read value: %v", err)
+	}
+	if err := d.Set("status", migration.Status); err != nil {
+		return diag.Errorf("unable to set resource database_migration `status` read value: %v", err)
+	}
+	if err := d.Set("method", migration.Method); err != nil {
+		return diag.Errorf("unable to set resource database_migration `method` read value: %v", err)
+	}
+	if err := d.Set("error", migration.Error); err != nil {
+		return diag.Errorf("unable to set resource database_migration `error` read value: %v", err)
+	}
+
+	// the password of the source is never returned, so it stays as configured
+	creds := migration.Credentials
+	if creds.Host != "" {
+		var ignored []string
+		if creds.IgnoredDatabases != "" {
+			ignored = strings.Split(creds.IgnoredDatabases, ",")
+		}
+
+		values := map[string]interface{}{
+			"host":              creds.Host,
+			"port":              creds.Port,
+			"username":          creds.Username,
+			"database":          creds.Database,
+			"ignored_databases": ignored,
+		}
+		if creds.SSL != nil {
+			values["ssl"] = *creds.SSL
+		}
+
+		for k, v := range values {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("unable to set resource database_migration `%s` read value: %v", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVultrDatabaseMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Detaching migration of database %s", d.Id())
+	if err := client.Database.DetachMigration(ctx, d.Id()); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("error detaching migration of database %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceVultrDatabaseMigrationImport reads the migration of a database by
+// the database ID. The source password has to be set in the configuration
+// again, since the API doesn't return it.
+func resourceVultrDatabaseMigrationImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	if err := d.Set("database_id", d.Id()); err != nil {
+		return nil, fmt.Errorf("unable to set database_id for import state function: %v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitForDatabaseMigration(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{"", "pending", "running", "syncing"},
+		Target:         []string{"done"},
+		Refresh:        newDatabaseMigrationStateRefresh(ctx, d, meta),
+		Timeout:        d.Timeout(schema.TimeoutCreate),
+		Delay:          10 * time.Second,
+		MinTimeout:     3 * time.Second,
+		NotFoundChecks: 60,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func newDatabaseMigrationStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) retry.StateRefreshFunc { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	return func() (interface{}, string, error) {
+		migration, _, err := client.Database.GetMigrationStatus(ctx, d.Id())
+		if err != nil {
+			return nil, "", fmt.Errorf("error retrieving migration of Managed Database %s : %s", d.Id(), err)
+		}
+		if migration == nil {
+			return nil, "", nil
+		}
+
+		log.Printf("[INFO] The Managed Database Migration Status is %s", migration.Status)
+		if migration.Status == "failed" {
+			return migration, migration.Status, fmt.Errorf("migration failed: %s", migration.Error)
+		}
+
+		return migration, migration.Status, nil
+	}
+}
@@ -0,0 +1,82 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrDatabaseMigrationBasic(t *testing.T) {
+	t.Parallel()
+	pName := acctest.RandomWithPrefix("tf-db-rs")
+	sName := acctest.RandomWithPrefix("tf-db-src")
+
+	name := "vultr_database_migration.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVultrDatabaseMigrationDestroy,
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseBase(pName) + testAccVultrDatabaseMigrationBase(sName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "status", "done"),
+					resource.TestCheckResourceAttrSet(name, "method"),
+					resource.TestCheckResourceAttrPair(name, "host", "vultr_database.source", "host"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckVultrDatabaseMigrationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_database_migration" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		migration, _, err := client.Database.GetMigrationStatus(context.Background(), rs.Primary.ID)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("error getting database migration: %s", err)
+		}
+
+		if migration != nil {
+			return fmt.Errorf("database %s is still migrating from %s", rs.Primary.ID, migration.Credentials.Host)
+		}
+	}
+	return nil
+}
+
+func testAccVultrDatabaseMigrationBase(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database" "source" {
+			database_engine = "pg"
+			database_engine_version = "15"
+			region = "sea"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s"
+		}
+
+		resource "vultr_database_migration" "test" {
+			database_id = vultr_database.test.id
+			host = vultr_database.source.host
+			port = vultr_database.source.port
+			username = vultr_database.source.user
+			password = vultr_database.source.password
+			database = vultr_database.source.dbname
+		} `, name)
+}
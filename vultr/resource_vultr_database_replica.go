@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -86,7 +85,7 @@ func resourceVultrDatabaseReplicaRead(ctx context.Context, d *schema.ResourceDat
 
 	database, _, err := client.Database.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid database ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing database read replica (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -22,6 +22,7 @@ func resourceVultrDNSDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrDNSDomainCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:         schema.TypeString,
@@ -41,6 +42,38 @@ func resourceVultrDNSDomain() *schema.Resource {
 				Default:      "disabled",
 				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
 			},
+			"soa": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsprimary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"email": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"dnssec_ds_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dnssec_dnskey_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -70,6 +103,13 @@ func resourceVultrDNSDomainCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(domain.Domain)
 
+	if soa, ok := d.GetOk("soa"); ok && len(soa.([]interface{})) > 0 && soa.([]interface{})[0] != nil {
+		log.Printf("[INFO] Updating SOA of domain (%s)", d.Id())
+		if err := client.Domain.UpdateSoa(ctx, d.Id(), expandDNSDomainSoa(soa.([]interface{}))); err != nil {
+			return diag.Errorf("error updating SOA of domain %s : %v", d.Id(), err)
+		}
+	}
+
 	return resourceVultrDNSDomainRead(ctx, d, meta)
 }
 
@@ -78,7 +118,7 @@ func resourceVultrDNSDomainRead(ctx context.Context, d *schema.ResourceData, met
 
 	domain, _, err := client.Domain.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid domain") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing domain (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
@@ -96,15 +136,45 @@ func resourceVultrDNSDomainRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("unable to set resource dns_domain `dns_sec` read value: %v", err)
 	}
 
+	soa, _, err := client.Domain.GetSoa(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error getting SOA of domain %s : %v", d.Id(), err)
+	}
+	if err := d.Set("soa", flattenDNSDomainSoa(soa)); err != nil {
+		return diag.Errorf("unable to set resource dns_domain `soa` read value: %v", err)
+	}
+
+	ds, dnskey, err := getDNSDomainSecRecords(ctx, client, domain)
+	if err != nil {
+		return diag.Errorf("error getting DNSSEC records of domain %s : %v", d.Id(), err)
+	}
+	if err := d.Set("dnssec_ds_records", ds); err != nil {
+		return diag.Errorf("unable to set resource dns_domain `dnssec_ds_records` read value: %v", err)
+	}
+	if err := d.Set("dnssec_dnskey_records", dnskey); err != nil {
+		return diag.Errorf("unable to set resource dns_domain `dnssec_dnskey_records` read value: %v", err)
+	}
+
 	return nil
 }
 
 func resourceVultrDNSDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Updated domain (%s)", d.Id())
-	if err := client.Domain.Update(ctx, d.Id(), d.Get("dns_sec").(string)); err != nil {
-		return diag.Errorf("error updating domain %s: %v", d.Id(), err)
+	if d.HasChange("dns_sec") {
+		log.Printf("[INFO] Updated domain (%s)", d.Id())
+		if err := client.Domain.Update(ctx, d.Id(), d.Get("dns_sec").(string)); err != nil {
+			return diag.Errorf("error updating domain %s: %v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("soa") {
+		if soa := d.Get("soa").([]interface{}); len(soa) > 0 && soa[0] != nil {
+			log.Printf("[INFO] Updating SOA of domain (%s)", d.Id())
+			if err := client.Domain.UpdateSoa(ctx, d.Id(), expandDNSDomainSoa(soa)); err != nil {
+				return diag.Errorf("error updating SOA of domain %s : %v", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceVultrDNSDomainRead(ctx, d, meta)
@@ -120,3 +190,76 @@ func resourceVultrDNSDomainDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// resourceVultrDNSDomainCustomizeDiff marks the DNSSEC records as unknown when
+// DNSSEC is switched on or off, so resources that publish them at a registrar
+// pick up the new keys in the same run
+func resourceVultrDNSDomainCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("dns_sec") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("dnssec_ds_records"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("dnssec_dnskey_records")
+}
+
+func expandDNSDomainSoa(soa []interface{}) *govultr.Soa {
+	s := soa[0].(map[string]interface{})
+
+	return &govultr.Soa{
+		NSPrimary: s["nsprimary"].(string),
+		Email:     s["email"].(string),
+	}
+}
+
+func flattenDNSDomainSoa(soa *govultr.Soa) []map[string]interface{} {
+	if soa == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"nsprimary": soa.NSPrimary,
+			"email":     soa.Email,
+		},
+	}
+}
+
+// getDNSDomainSecRecords returns the DS and DNSKEY records of a domain with
+// DNSSEC enabled, in presentation format, for publishing at the registrar
+func getDNSDomainSecRecords(ctx context.Context, client *govultr.Client, domain *govultr.Domain) ([]string, []string, error) { //nolint:lll
+	ds, dnskey := []string{}, []string{}
+	if domain.DNSSec != "enabled" {
+		return ds, dnskey, nil
+	}
+
+	records, _, err := client.Domain.GetDNSSec(ctx, domain.Domain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, r := range records {
+		switch dnsSecRecordType(r) {
+		case "DS":
+			ds = append(ds, r)
+		case "DNSKEY":
+			dnskey = append(dnskey, r)
+		}
+	}
+
+	return ds, dnskey, nil
+}
+
+// dnsSecRecordType finds the type of a record such as
+// "example.com IN DS 12345 13 2 ..."
+func dnsSecRecordType(record string) string {
+	for _, f := range strings.Fields(record) {
+		if t := strings.ToUpper(f); t == "DS" || t == "DNSKEY" {
+			return t
+		}
+	}
+
+	return ""
+}
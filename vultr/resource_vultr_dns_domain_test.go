@@ -69,6 +69,35 @@ func TestAccVultrDNSDomainNewDomainForce(t *testing.T) {
 	})
 }
 
+func TestAccVultrDNSDomainSec(t *testing.T) {
+	rString := acctest.RandString(6) + ".com"
+	name := "vultr_dns_domain.my-site"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDNSDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDNSDomainSec(rString, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "soa.0.email", "hostmaster@"+rString),
+					resource.TestCheckResourceAttrSet(name, "soa.0.nsprimary"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_records.#", "0"),
+				),
+			},
+			{
+				Config: testAccVultrDNSDomainSec(rString, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "dns_sec", "enabled"),
+					resource.TestCheckResourceAttrSet(name, "dnssec_ds_records.0"),
+					resource.TestCheckResourceAttrSet(name, "dnssec_dnskey_records.0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVultrDNSDomainDestroy(s *terraform.State) error {
 	time.Sleep(1 * time.Second)
 	client := testAccProvider.Meta().(*Client).govultrClient()
@@ -103,3 +132,16 @@ func testAccVultrDNSDomainUpdate(domain string) string {
 			ip = "10.0.0.1"
 		}`, domain)
 }
+
+func testAccVultrDNSDomainSec(domain, dnsSec string) string {
+	time.Sleep(1 * time.Second)
+	return fmt.Sprintf(`
+		resource "vultr_dns_domain" "my-site" {
+			domain = "%[1]s"
+			dns_sec = "%[2]s"
+
+			soa {
+				email = "hostmaster@%[1]s"
+			}
+		}`, domain, dnsSec)
+}
@@ -83,9 +83,12 @@ func resourceVultrDNSRecordRead(ctx context.Context, d *schema.ResourceData, met
 
 	record, _, err := client.DomainRecord.Get(ctx, d.Get("domain").(string), d.Id())
 	if err != nil {
-		log.Printf("[WARN] DNS Record %s not found", d.Id())
-		d.SetId("")
-		return nil
+		if isNotFoundError(err) {
+			log.Printf("[WARN] DNS Record %s not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting DNS record %s : %v", d.Id(), err)
 	}
 
 	if err := d.Set("domain", d.Get("domain").(string)); err != nil {
@@ -0,0 +1,173 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceVultrDNSZoneFile reconciles the records of a domain with a BIND
+// zone file. Like vultr_dns_zone_records it owns every record of the domain.
+func resourceVultrDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrDNSZoneFileCreate,
+		ReadContext:   resourceVultrDNSZoneFileRead,
+		UpdateContext: resourceVultrDNSZoneFileUpdate,
+		DeleteContext: resourceVultrDNSZoneFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrDNSZoneRecordsImport,
+		},
+		CustomizeDiff: resourceVultrDNSZoneFileCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"zone_file": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: diffSuppressZoneFile,
+			},
+			"keep_managed_records": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVultrDNSZoneFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domain := d.Get("domain").(string)
+	keep := d.Get("keep_managed_records").(bool)
+
+	records, err := parseZoneFile(d.Get("zone_file").(string), domain, keep)
+	if err != nil {
+		return diag.Errorf("error parsing zone file for %s : %v", domain, err)
+	}
+
+	log.Printf("[INFO] Creating DNS zone file records for %s", domain)
+	if err := reconcileDNSZoneRecords(ctx, meta.(*Client).govultrClient(), domain, keep, records); err != nil {
+		return diag.Errorf("error creating DNS zone file records for %s : %v", domain, err)
+	}
+
+	d.SetId(domain)
+	return resourceVultrDNSZoneFileRead(ctx, d, meta)
+}
+
+func resourceVultrDNSZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	list, err := listDNSZoneRecords(ctx, client, d.Id(), d.Get("keep_managed_records").(bool))
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Domain %s not found, removing its zone file from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting DNS zone records for %s : %v", d.Id(), err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(list))
+	for i := range list {
+		records = append(records, flattenDNSZoneRecord(&list[i]))
+	}
+
+	// keep the zone file as written while it still describes the records
+	zoneFile := d.Get("zone_file").(string)
+	parsed, err := parseZoneFile(zoneFile, d.Id(), d.Get("keep_managed_records").(bool))
+	if zoneFile == "" || err != nil || !sameZoneRecords(parsed, records) {
+		zoneFile = renderZoneFile(d.Id(), records)
+	}
+
+	if err := d.Set("domain", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource dns_zone_file `domain` read value: %v", err)
+	}
+	if err := d.Set("zone_file", zoneFile); err != nil {
+		return diag.Errorf("unable to set resource dns_zone_file `zone_file` read value: %v", err)
+	}
+	if err := d.Set("record_count", len(records)); err != nil {
+		return diag.Errorf("unable to set resource dns_zone_file `record_count` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrDNSZoneFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keep := d.Get("keep_managed_records").(bool)
+
+	records, err := parseZoneFile(d.Get("zone_file").(string), d.Id(), keep)
+	if err != nil {
+		return diag.Errorf("error parsing zone file for %s : %v", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Updating DNS zone file records for %s", d.Id())
+	if err := reconcileDNSZoneRecords(ctx, meta.(*Client).govultrClient(), d.Id(), keep, records); err != nil {
+		return diag.Errorf("error updating DNS zone file records for %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrDNSZoneFileRead(ctx, d, meta)
+}
+
+func resourceVultrDNSZoneFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keep := d.Get("keep_managed_records").(bool)
+
+	records, err := parseZoneFile(d.Get("zone_file").(string), d.Id(), keep)
+	if err != nil {
+		return diag.Errorf("error parsing zone file for %s : %v", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting DNS zone file records for %s", d.Id())
+	if err := deleteDNSZoneRecords(ctx, meta.(*Client).govultrClient(), d.Id(), keep, records); err != nil {
+		return diag.Errorf("error deleting DNS zone file records for %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceVultrDNSZoneFileCustomizeDiff reports zone file syntax errors at
+// plan time
+func resourceVultrDNSZoneFileCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	domain, zoneFile := d.Get("domain").(string), d.Get("zone_file").(string)
+	if domain == "" || zoneFile == "" || !d.NewValueKnown("zone_file") || !d.NewValueKnown("domain") {
+		return nil
+	}
+
+	if _, err := parseZoneFile(zoneFile, domain, d.Get("keep_managed_records").(bool)); err != nil {
+		return fmt.Errorf("invalid zone_file: %v", err)
+	}
+
+	return nil
+}
+
+// diffSuppressZoneFile ignores changes to the zone file text, such as
+// comments, ordering or relative names, that leave its records the same
+func diffSuppressZoneFile(_, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	domain := d.Get("domain").(string)
+	keep := d.Get("keep_managed_records").(bool)
+
+	oldRecords, err := parseZoneFile(old, domain, keep)
+	if err != nil {
+		return false
+	}
+	newRecords, err := parseZoneFile(new, domain, keep)
+	if err != nil {
+		return false
+	}
+
+	return sameZoneRecords(oldRecords, newRecords)
+}
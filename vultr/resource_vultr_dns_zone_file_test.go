@@ -0,0 +1,57 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrDNSZoneFileBasic(t *testing.T) {
+	domain := fmt.Sprintf("%s.com", acctest.RandString(6))
+	name := "vultr_dns_zone_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDNSZoneFileConfig(domain, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domain", domain),
+					resource.TestCheckResourceAttr(name, "record_count", "3"),
+				),
+			},
+			{
+				Config: testAccVultrDNSZoneFileConfig(domain, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record_count", "3"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func testAccVultrDNSZoneFileConfig(domain, ip string) string {
+	return fmt.Sprintf(`
+		resource "vultr_dns_domain" "test" {
+			domain = "%s"
+		}
+
+		resource "vultr_dns_zone_file" "test" {
+			domain    = vultr_dns_domain.test.id
+			zone_file = <<-EOT
+				$TTL 300
+				@    IN A     %s
+				www  IN CNAME @
+				@    IN MX    10 mail.example.com.
+			EOT
+		}`, domain, ip)
+}
@@ -0,0 +1,370 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrDNSZoneRecords owns every record of a domain. Records that
+// exist on the domain but not in the configuration show up as drift and are
+// removed on apply.
+func resourceVultrDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrDNSZoneRecordsCreate,
+		ReadContext:   resourceVultrDNSZoneRecordsRead,
+		UpdateContext: resourceVultrDNSZoneRecordsUpdate,
+		DeleteContext: resourceVultrDNSZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrDNSZoneRecordsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"keep_managed_records": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashDNSZoneRecord,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{"A", "AAAA", "CNAME", "NS", "MX", "SRV", "TXT", "CAA", "SSHFP"},
+								false,
+							),
+						},
+						"data": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressDNSZoneRecordDataDiff,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  3600,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVultrDNSZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domain := d.Get("domain").(string)
+
+	log.Printf("[INFO] Creating DNS zone records for %s", domain)
+	err := reconcileDNSZoneRecords(ctx, meta.(*Client).govultrClient(), domain,
+		d.Get("keep_managed_records").(bool), expandDNSZoneRecords(d))
+	if err != nil {
+		return diag.Errorf("error creating DNS zone records for %s : %v", domain, err)
+	}
+
+	d.SetId(domain)
+	return resourceVultrDNSZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceVultrDNSZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	records, err := listDNSZoneRecords(ctx, client, d.Id(), d.Get("keep_managed_records").(bool))
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Domain %s not found, removing its zone records from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting DNS zone records for %s : %v", d.Id(), err)
+	}
+
+	recordList := make([]interface{}, 0, len(records))
+	for i := range records {
+		recordList = append(recordList, flattenDNSZoneRecord(&records[i]))
+	}
+
+	if err := d.Set("domain", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource dns_zone_records `domain` read value: %v", err)
+	}
+	if err := d.Set("record", recordList); err != nil {
+		return diag.Errorf("unable to set resource dns_zone_records `record` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrDNSZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Updating DNS zone records for %s", d.Id())
+	err := reconcileDNSZoneRecords(ctx, meta.(*Client).govultrClient(), d.Id(),
+		d.Get("keep_managed_records").(bool), expandDNSZoneRecords(d))
+	if err != nil {
+		return diag.Errorf("error updating DNS zone records for %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrDNSZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceVultrDNSZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Deleting DNS zone records for %s", d.Id())
+	err := deleteDNSZoneRecords(ctx, meta.(*Client).govultrClient(), d.Id(),
+		d.Get("keep_managed_records").(bool), expandDNSZoneRecords(d))
+	if err != nil {
+		return diag.Errorf("error deleting DNS zone records for %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVultrDNSZoneRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	if _, _, err := client.Domain.Get(ctx, d.Id()); err != nil {
+		return nil, fmt.Errorf("domain %s not found: %v", d.Id(), err)
+	}
+
+	if err := d.Set("domain", d.Id()); err != nil {
+		return nil, fmt.Errorf("unable to set resource dns_zone_records `domain` import value: %v", err)
+	}
+	if err := d.Set("keep_managed_records", true); err != nil {
+		return nil, fmt.Errorf("unable to set resource dns_zone_records `keep_managed_records` import value: %v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandDNSZoneRecords(d *schema.ResourceData) []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, r := range d.Get("record").(*schema.Set).List() {
+		records = append(records, r.(map[string]interface{}))
+	}
+
+	return records
+}
+
+// reconcileDNSZoneRecords brings the records of the domain in line with the
+// wanted records, touching only the records that differ. Records that only
+// changed data, ttl or priority are updated in place, everything else is
+// deleted or created.
+func reconcileDNSZoneRecords(ctx context.Context, client *govultr.Client, domain string, skipManaged bool, wanted []map[string]interface{}) error { //nolint:lll
+	current, err := listDNSZoneRecords(ctx, client, domain, skipManaged)
+	if err != nil {
+		return err
+	}
+
+	// records that already match need no change
+	unmatched := make([]bool, len(current))
+	for i := range current {
+		unmatched[i] = true
+	}
+	var missing []map[string]interface{}
+	for _, w := range wanted {
+		found := false
+		for i := range current {
+			if unmatched[i] && dnsZoneRecordKey(flattenDNSZoneRecord(&current[i])) == dnsZoneRecordKey(w) {
+				unmatched[i], found = false, true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, w)
+		}
+	}
+
+	// pair the rest up by name and type so they can be updated in place
+	type update struct {
+		id     string
+		record map[string]interface{}
+	}
+	var creates []map[string]interface{}
+	var updates []update
+	for _, w := range missing {
+		paired := false
+		for i := range current {
+			if unmatched[i] && current[i].Name == w["name"].(string) && current[i].Type == w["type"].(string) {
+				unmatched[i], paired = false, true
+				updates = append(updates, update{id: current[i].ID, record: w})
+				break
+			}
+		}
+		if !paired {
+			creates = append(creates, w)
+		}
+	}
+
+	for i := range current {
+		if !unmatched[i] {
+			continue
+		}
+		log.Printf("[INFO] Deleting DNS record %s %s %s", current[i].ID, current[i].Type, current[i].Name)
+		if err := client.DomainRecord.Delete(ctx, domain, current[i].ID); err != nil {
+			return fmt.Errorf("error deleting DNS record %s : %v", current[i].ID, err)
+		}
+	}
+
+	for _, u := range updates {
+		id, w := u.id, u.record
+		log.Printf("[INFO] Updating DNS record %s", id)
+		priority := w["priority"].(int)
+		req := &govultr.DomainRecordUpdateReq{
+			Name:     govultr.StringToStringPtr(w["name"].(string)),
+			Data:     w["data"].(string),
+			TTL:      w["ttl"].(int),
+			Priority: &priority,
+		}
+		if err := client.DomainRecord.Update(ctx, domain, id, req); err != nil {
+			return fmt.Errorf("error updating DNS record %s : %v", id, err)
+		}
+	}
+
+	for _, w := range creates {
+		log.Printf("[INFO] Creating DNS record %s %s", w["type"], w["name"])
+		priority := w["priority"].(int)
+		req := &govultr.DomainRecordCreateReq{
+			Name:     w["name"].(string),
+			Type:     w["type"].(string),
+			Data:     w["data"].(string),
+			TTL:      w["ttl"].(int),
+			Priority: &priority,
+		}
+		if _, _, err := client.DomainRecord.Create(ctx, domain, req); err != nil {
+			return fmt.Errorf("error creating DNS record %s %s : %v", w["type"], w["name"], err)
+		}
+	}
+
+	return nil
+}
+
+// deleteDNSZoneRecords deletes the records of the domain that match the
+// managed ones. Records added since are left alone.
+func deleteDNSZoneRecords(ctx context.Context, client *govultr.Client, domain string, skipManaged bool, managed []map[string]interface{}) error { //nolint:lll
+	records, err := listDNSZoneRecords(ctx, client, domain, skipManaged)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	keys := map[string]bool{}
+	for _, r := range managed {
+		keys[dnsZoneRecordKey(r)] = true
+	}
+
+	for i := range records {
+		if !keys[dnsZoneRecordKey(flattenDNSZoneRecord(&records[i]))] {
+			continue
+		}
+		if err := client.DomainRecord.Delete(ctx, domain, records[i].ID); err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("error deleting DNS record %s : %v", records[i].ID, err)
+		}
+	}
+
+	return nil
+}
+
+// listDNSZoneRecords returns every record of a domain but the SOA, which is
+// managed through vultr_dns_domain. The apex NS records Vultr manages are
+// left out too when those are kept out of the record set.
+func listDNSZoneRecords(ctx context.Context, client *govultr.Client, domain string, skipManaged bool) ([]govultr.DomainRecord, error) { //nolint:lll
+	var records []govultr.DomainRecord
+
+	options := &govultr.ListOptions{}
+	for {
+		list, meta, _, err := client.DomainRecord.List(ctx, domain, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range list {
+			if list[i].Type == "SOA" || (skipManaged && isManagedDNSRecord(&list[i])) {
+				continue
+			}
+			records = append(records, list[i])
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	return records, nil
+}
+
+func isManagedDNSRecord(r *govultr.DomainRecord) bool {
+	return r.Type == "NS" && r.Name == ""
+}
+
+// flattenDNSZoneRecord returns a record as an element of the record set. The
+// API reports a priority of -1 for record types without one.
+func flattenDNSZoneRecord(r *govultr.DomainRecord) map[string]interface{} {
+	priority := 0
+	if (r.Type == "MX" || r.Type == "SRV") && r.Priority > 0 {
+		priority = r.Priority
+	}
+
+	return map[string]interface{}{
+		"name":     r.Name,
+		"type":     r.Type,
+		"data":     normalizeDNSZoneRecordData(r.Type, r.Data),
+		"ttl":      r.TTL,
+		"priority": priority,
+	}
+}
+
+func hashDNSZoneRecord(v interface{}) int {
+	return schema.HashString(dnsZoneRecordKey(v.(map[string]interface{})))
+}
+
+// dnsZoneRecordKey identifies a record by its content, with the data in
+// normalized form.
+func dnsZoneRecordKey(r map[string]interface{}) string {
+	data := normalizeDNSZoneRecordData(r["type"].(string), r["data"].(string))
+
+	return fmt.Sprintf("%s|%s|%s|%v|%v", r["name"], r["type"], data, r["ttl"], r["priority"])
+}
+
+// normalizeDNSZoneRecordData drops the quotes around TXT data and the
+// trailing dot of host names, since either form ends up as the same record.
+func normalizeDNSZoneRecordData(recordType, data string) string {
+	switch recordType {
+	case "TXT":
+		return unquoteTXT(data)
+	case "CNAME", "NS", "MX", "SRV":
+		return strings.TrimSuffix(data, ".")
+	}
+
+	return data
+}
+
+func suppressDNSZoneRecordDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	recordType := d.Get(strings.TrimSuffix(k, "data") + "type").(string)
+
+	return normalizeDNSZoneRecordData(recordType, old) == normalizeDNSZoneRecordData(recordType, new)
+}
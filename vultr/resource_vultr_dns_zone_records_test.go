@@ -0,0 +1,66 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrDNSZoneRecordsBasic(t *testing.T) {
+	domain := fmt.Sprintf("%s.com", acctest.RandString(6))
+	name := "vultr_dns_zone_records.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDNSZoneRecordsConfig(domain, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domain", domain),
+					resource.TestCheckResourceAttr(name, "record.#", "2"),
+				),
+			},
+			{
+				Config: testAccVultrDNSZoneRecordsConfig(domain, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "record.*", map[string]string{
+						"type": "A",
+						"data": "10.0.0.2",
+					}),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVultrDNSZoneRecordsConfig(domain, ip string) string {
+	return fmt.Sprintf(`
+		resource "vultr_dns_domain" "test" {
+			domain = "%s"
+		}
+
+		resource "vultr_dns_zone_records" "test" {
+			domain = vultr_dns_domain.test.id
+
+			record {
+				type = "A"
+				data = "%s"
+				ttl  = 300
+			}
+
+			record {
+				name = "www"
+				type = "CNAME"
+				data = "%s"
+			}
+		}`, domain, ip, domain)
+}
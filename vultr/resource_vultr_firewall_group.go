@@ -3,7 +3,6 @@ package vultr
 import (
 	"context"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,7 +56,7 @@ func resourceVultrFirewallGroupRead(ctx context.Context, d *schema.ResourceData,
 
 	group, _, err := client.FirewallGroup.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "\"status\":404") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing firewall group (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
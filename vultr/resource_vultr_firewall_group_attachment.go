@@ -0,0 +1,153 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrFirewallGroupAttachment puts an instance in a firewall group
+// without touching the instance definition, so firewall membership can live
+// in a different state than the servers. The ID is the instance ID, since an
+// instance belongs to at most one firewall group.
+func resourceVultrFirewallGroupAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrFirewallGroupAttachmentCreate,
+		ReadContext:   resourceVultrFirewallGroupAttachmentRead,
+		DeleteContext: resourceVultrFirewallGroupAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"firewall_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceVultrFirewallGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instanceID := d.Get("instance_id").(string)
+	groupID := d.Get("firewall_group_id").(string)
+
+	instance, _, err := client.Instance.Get(ctx, instanceID)
+	if err != nil {
+		return diag.Errorf("error getting instance %s : %v", instanceID, err)
+	}
+
+	// an instance is in one firewall group at most, so attaching would
+	// silently take it out of a group set elsewhere
+	if instance.FirewallGroupID != "" && instance.FirewallGroupID != groupID {
+		return diag.Errorf(
+			"instance %s is already in firewall group %s. Remove firewall_group_id from its vultr_instance "+
+				"or the other vultr_firewall_group_attachment before attaching it to %s",
+			instanceID, instance.FirewallGroupID, groupID,
+		)
+	}
+
+	if instance.FirewallGroupID != groupID {
+		log.Printf("[INFO] Attaching instance %s to firewall group %s", instanceID, groupID)
+		req := &govultr.InstanceUpdateReq{FirewallGroupID: groupID}
+		if _, _, err := client.Instance.Update(ctx, instanceID, req); err != nil {
+			return diag.Errorf("error attaching instance %s to firewall group %s : %v", instanceID, groupID, err)
+		}
+	}
+
+	d.SetId(instanceID)
+
+	return resourceVultrFirewallGroupAttachmentRead(ctx, d, meta)
+}
+
+func resourceVultrFirewallGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instance, _, err := client.Instance.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Instance %s not found, removing firewall group attachment from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting instance %s : %v", d.Id(), err)
+	}
+
+	if instance.FirewallGroupID == "" {
+		log.Printf("[WARN] Instance %s is no longer in a firewall group, removing attachment from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// the instance was moved to another group, most likely by the
+	// firewall_group_id of its vultr_instance. Dropping the attachment makes
+	// the next apply report the conflict instead of moving it back.
+	if groupID := d.Get("firewall_group_id").(string); groupID != "" && groupID != instance.FirewallGroupID {
+		log.Printf("[WARN] Instance %s moved from firewall group %s to %s, removing attachment from state",
+			d.Id(), groupID, instance.FirewallGroupID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("instance_id", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource firewall_group_attachment `instance_id` read value: %v", err)
+	}
+	if err := d.Set("firewall_group_id", instance.FirewallGroupID); err != nil {
+		return diag.Errorf("unable to set resource firewall_group_attachment `firewall_group_id` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrFirewallGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instance, _, err := client.Instance.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.Errorf("error getting instance %s : %v", d.Id(), err)
+	}
+
+	// leave the instance alone when something else moved it already
+	if instance.FirewallGroupID != d.Get("firewall_group_id").(string) {
+		return nil
+	}
+
+	log.Printf("[INFO] Detaching instance %s from firewall group %s", d.Id(), instance.FirewallGroupID)
+	if err := detachInstanceFirewallGroup(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error detaching instance %s from firewall group %s : %v", d.Id(), instance.FirewallGroupID, err)
+	}
+
+	return nil
+}
+
+// detachInstanceFirewallGroup clears the firewall group of an instance.
+// InstanceUpdateReq leaves out an empty firewall group, so the request is
+// made directly.
+func detachInstanceFirewallGroup(ctx context.Context, client *govultr.Client, instanceID string) error {
+	req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("/v2/instances/%s", instanceID),
+		map[string]string{"firewall_group_id": ""})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoWithContext(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,55 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrFirewallGroupAttachmentBasic(t *testing.T) {
+	rString := acctest.RandString(13)
+	name := "vultr_firewall_group_attachment.fwg"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrFirewallGroupAttachmentConfig(rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "instance_id", "vultr_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(name, "firewall_group_id", "vultr_firewall_group.fwg", "id"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVultrFirewallGroupAttachmentConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_instance" "test" {
+			plan = "vc2-1c-2gb"
+			region = "sea"
+			os_id = 167
+			label = "%[1]s"
+			hostname = "testing-the-hostname"
+			activation_email = false
+		}
+
+		resource "vultr_firewall_group" "fwg" {
+			description = "%[1]s"
+		}
+
+		resource "vultr_firewall_group_attachment" "fwg" {
+			instance_id       = vultr_instance.test.id
+			firewall_group_id = vultr_firewall_group.fwg.id
+		}`, name)
+}
@@ -0,0 +1,511 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrFirewallGroupRules owns every IPv4 and IPv6 rule of a firewall
+// group. Rules added outside of terraform show up as drift and are removed on
+// apply. Besides single rules, an allow block lets one set of ports through
+// from a list of CIDRs, load balancers or Cloudflare, and stands for one API
+// rule per source and IP type.
+func resourceVultrFirewallGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrFirewallGroupRulesCreate,
+		ReadContext:   resourceVultrFirewallGroupRulesRead,
+		UpdateContext: resourceVultrFirewallGroupRulesUpdate,
+		DeleteContext: resourceVultrFirewallGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceVultrFirewallGroupRulesCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"firewall_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashFirewallGroupRule,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"v4", "v6"}, false),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"icmp", "tcp", "udp", "gre", "ah", "esp"}, false),
+						},
+						"subnet": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.IsIPAddress,
+							DiffSuppressFunc: suppressIPDiff,
+						},
+						"subnet_size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							ValidateFunc: validation.Any(validation.StringIsEmpty, validatePortOrPortRange),
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			"allow": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"icmp", "tcp", "udp", "gre", "ah", "esp"}, false),
+						},
+						"port": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							ValidateFunc: validation.Any(validation.StringIsEmpty, validatePortOrPortRange),
+						},
+						"cidrs": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+						"load_balancer_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cloudflare": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVultrFirewallGroupRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	groupID := d.Get("firewall_group_id").(string)
+
+	log.Printf("[INFO] Creating firewall group %s rules", groupID)
+	err := reconcileFirewallGroupRules(ctx, meta.(*Client).govultrClient(), groupID, wantedFirewallGroupRules(d))
+	if err != nil {
+		return diag.Errorf("error creating firewall group %s rules : %v", groupID, err)
+	}
+
+	d.SetId(groupID)
+	return resourceVultrFirewallGroupRulesRead(ctx, d, meta)
+}
+
+func resourceVultrFirewallGroupRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	if _, _, err := client.FirewallGroup.Get(ctx, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Firewall group %s not found, removing its rules from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting firewall group %s : %v", d.Id(), err)
+	}
+
+	rules, err := listFirewallGroupRules(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error getting firewall group %s rules : %v", d.Id(), err)
+	}
+
+	allowList, rest := matchFirewallGroupAllow(expandFirewallGroupAllow(d), rules)
+
+	ruleList := make([]interface{}, 0, len(rest))
+	for i := range rest {
+		ruleList = append(ruleList, flattenFirewallGroupRule(&rest[i]))
+	}
+
+	if err := d.Set("firewall_group_id", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource firewall_group_rules `firewall_group_id` read value: %v", err)
+	}
+	if err := d.Set("rule", ruleList); err != nil {
+		return diag.Errorf("unable to set resource firewall_group_rules `rule` read value: %v", err)
+	}
+	if err := d.Set("allow", allowList); err != nil {
+		return diag.Errorf("unable to set resource firewall_group_rules `allow` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrFirewallGroupRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	log.Printf("[INFO] Updating firewall group %s rules", d.Id())
+	err := reconcileFirewallGroupRules(ctx, meta.(*Client).govultrClient(), d.Id(), wantedFirewallGroupRules(d))
+	if err != nil {
+		return diag.Errorf("error updating firewall group %s rules : %v", d.Id(), err)
+	}
+
+	return resourceVultrFirewallGroupRulesRead(ctx, d, meta)
+}
+
+func resourceVultrFirewallGroupRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	rules, err := listFirewallGroupRules(ctx, client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.Errorf("error getting firewall group %s rules : %v", d.Id(), err)
+	}
+
+	managed := map[string]bool{}
+	for _, r := range wantedFirewallGroupRules(d) {
+		managed[firewallGroupRuleKey(r)] = true
+	}
+
+	log.Printf("[INFO] Deleting firewall group %s rules", d.Id())
+	for i := range rules {
+		if !managed[firewallGroupRuleKey(flattenFirewallGroupRule(&rules[i]))] {
+			continue
+		}
+		if err := client.FirewallRule.Delete(ctx, d.Id(), rules[i].ID); err != nil && !isNotFoundError(err) {
+			return diag.Errorf("error deleting firewall rule %d : %v", rules[i].ID, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceVultrFirewallGroupRulesCustomizeDiff checks at plan time that ports
+// are only given for tcp and udp rules and that every allow block has a source
+func resourceVultrFirewallGroupRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.NewValueKnown("rule") {
+		for _, v := range d.Get("rule").(*schema.Set).List() {
+			r := v.(map[string]interface{})
+			if err := validateFirewallRulePort(r["protocol"].(string), r["port"].(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.NewValueKnown("allow") {
+		for _, v := range d.Get("allow").(*schema.Set).List() {
+			a := v.(map[string]interface{})
+			if err := validateFirewallRulePort(a["protocol"].(string), a["port"].(string)); err != nil {
+				return err
+			}
+			if len(firewallGroupAllowSources(a)) == 0 {
+				return fmt.Errorf("allow block for %s needs at least one of cidrs, load_balancer_ids or cloudflare", a["protocol"])
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateFirewallRulePort(protocol, port string) error {
+	if port != "" && protocol != "tcp" && protocol != "udp" {
+		return fmt.Errorf("port %s can only be set for tcp and udp rules, not %s", port, protocol)
+	}
+
+	return nil
+}
+
+func expandFirewallGroupRules(d *schema.ResourceData) []map[string]interface{} {
+	var rules []map[string]interface{}
+	for _, r := range d.Get("rule").(*schema.Set).List() {
+		rules = append(rules, r.(map[string]interface{}))
+	}
+
+	return rules
+}
+
+func expandFirewallGroupAllow(d *schema.ResourceData) []map[string]interface{} {
+	var allow []map[string]interface{}
+	for _, a := range d.Get("allow").(*schema.Set).List() {
+		allow = append(allow, a.(map[string]interface{}))
+	}
+
+	return allow
+}
+
+// wantedFirewallGroupRules returns the rules blocks together with the rules
+// every allow block expands into
+func wantedFirewallGroupRules(d *schema.ResourceData) []map[string]interface{} {
+	rules := expandFirewallGroupRules(d)
+	for _, a := range expandFirewallGroupAllow(d) {
+		for _, source := range firewallGroupAllowSources(a) {
+			rules = append(rules, source.rules...)
+		}
+	}
+
+	return rules
+}
+
+// firewallAllowSource is one source of an allow block, such as a single CIDR,
+// along with the API rules it stands for
+type firewallAllowSource struct {
+	kind  string
+	value string
+	rules []map[string]interface{}
+}
+
+// firewallGroupAllowSources expands an allow block into its sources. A CIDR
+// becomes a rule of its own IP type, while a load balancer or Cloudflare
+// becomes a v4 and a v6 rule.
+func firewallGroupAllowSources(a map[string]interface{}) []firewallAllowSource {
+	rule := func(ipType, subnet string, size int, source string) map[string]interface{} {
+		return map[string]interface{}{
+			"ip_type":     ipType,
+			"protocol":    a["protocol"],
+			"subnet":      subnet,
+			"subnet_size": size,
+			"port":        a["port"],
+			"source":      source,
+			"notes":       a["notes"],
+		}
+	}
+	anywhere := func(source string) []map[string]interface{} {
+		return []map[string]interface{}{rule("v4", "0.0.0.0", 0, source), rule("v6", "::", 0, source)}
+	}
+
+	var sources []firewallAllowSource
+	for _, v := range a["cidrs"].(*schema.Set).List() {
+		_, network, err := net.ParseCIDR(v.(string))
+		if err != nil {
+			continue
+		}
+		size, _ := network.Mask.Size()
+		ipType := "v6"
+		if network.IP.To4() != nil {
+			ipType = "v4"
+		}
+		sources = append(sources, firewallAllowSource{
+			kind:  "cidrs",
+			value: v.(string),
+			rules: []map[string]interface{}{rule(ipType, network.IP.String(), size, "")},
+		})
+	}
+	for _, v := range a["load_balancer_ids"].(*schema.Set).List() {
+		sources = append(sources, firewallAllowSource{
+			kind:  "load_balancer_ids",
+			value: v.(string),
+			rules: anywhere(v.(string)),
+		})
+	}
+	if a["cloudflare"].(bool) {
+		sources = append(sources, firewallAllowSource{kind: "cloudflare", rules: anywhere("cloudflare")})
+	}
+
+	return sources
+}
+
+// matchFirewallGroupAllow tracks the API rules of each allow block. A source
+// only stays in its block while all of its rules exist, so a rule removed
+// outside of terraform shows up as drift. Rules no block accounts for are
+// returned for the rule blocks.
+func matchFirewallGroupAllow(allow []map[string]interface{}, rules []govultr.FirewallRule) ([]interface{}, []govultr.FirewallRule) { //nolint:lll
+	pool := map[string][]int{}
+	for i := range rules {
+		key := firewallGroupRuleKey(flattenFirewallGroupRule(&rules[i]))
+		pool[key] = append(pool[key], i)
+	}
+
+	claimed := make([]bool, len(rules))
+	allowList := make([]interface{}, 0, len(allow))
+	for _, a := range allow {
+		cidrs, lbs, cloudflare := []interface{}{}, []interface{}{}, false
+		for _, source := range firewallGroupAllowSources(a) {
+			taken := map[string]int{}
+			present := true
+			for _, r := range source.rules {
+				key := firewallGroupRuleKey(r)
+				if len(pool[key]) <= taken[key] {
+					present = false
+					break
+				}
+				taken[key]++
+			}
+			if !present {
+				continue
+			}
+
+			for key, n := range taken {
+				for _, i := range pool[key][:n] {
+					claimed[i] = true
+				}
+				pool[key] = pool[key][n:]
+			}
+			switch source.kind {
+			case "cidrs":
+				cidrs = append(cidrs, source.value)
+			case "load_balancer_ids":
+				lbs = append(lbs, source.value)
+			case "cloudflare":
+				cloudflare = true
+			}
+		}
+
+		if len(cidrs) == 0 && len(lbs) == 0 && !cloudflare {
+			continue
+		}
+		allowList = append(allowList, map[string]interface{}{
+			"protocol":          a["protocol"],
+			"port":              a["port"],
+			"cidrs":             cidrs,
+			"load_balancer_ids": lbs,
+			"cloudflare":        cloudflare,
+			"notes":             a["notes"],
+		})
+	}
+
+	var rest []govultr.FirewallRule
+	for i := range rules {
+		if !claimed[i] {
+			rest = append(rest, rules[i])
+		}
+	}
+
+	return allowList, rest
+}
+
+// reconcileFirewallGroupRules brings the rules of a firewall group in line
+// with the wanted rules. Rules can't be changed in place, so missing rules
+// are created before the rules no longer wanted are deleted, which keeps
+// traffic that both allow flowing during the change.
+func reconcileFirewallGroupRules(ctx context.Context, client *govultr.Client, groupID string, wanted []map[string]interface{}) error { //nolint:lll
+	current, err := listFirewallGroupRules(ctx, client, groupID)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string][]int{}
+	for i := range current {
+		key := firewallGroupRuleKey(flattenFirewallGroupRule(&current[i]))
+		existing[key] = append(existing[key], current[i].ID)
+	}
+
+	for _, w := range wanted {
+		key := firewallGroupRuleKey(w)
+		if ids := existing[key]; len(ids) > 0 {
+			existing[key] = ids[1:]
+			continue
+		}
+
+		req := &govultr.FirewallRuleReq{
+			IPType:     w["ip_type"].(string),
+			Protocol:   w["protocol"].(string),
+			Subnet:     w["subnet"].(string),
+			SubnetSize: w["subnet_size"].(int),
+			Port:       w["port"].(string),
+			Source:     w["source"].(string),
+			Notes:      w["notes"].(string),
+		}
+		log.Printf("[INFO] Creating firewall rule %s", key)
+		if _, _, err := client.FirewallRule.Create(ctx, groupID, req); err != nil {
+			return fmt.Errorf("error creating firewall rule %s : %v", key, err)
+		}
+	}
+
+	for _, ids := range existing {
+		for _, id := range ids {
+			log.Printf("[INFO] Deleting firewall rule %d", id)
+			if err := client.FirewallRule.Delete(ctx, groupID, id); err != nil {
+				return fmt.Errorf("error deleting firewall rule %d : %v", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// listFirewallGroupRules returns every rule of a firewall group, IPv4 and
+// IPv6 alike
+func listFirewallGroupRules(ctx context.Context, client *govultr.Client, groupID string) ([]govultr.FirewallRule, error) { //nolint:lll
+	var rules []govultr.FirewallRule
+
+	options := &govultr.ListOptions{}
+	for {
+		list, meta, _, err := client.FirewallRule.List(ctx, groupID, options)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, list...)
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	return rules, nil
+}
+
+func flattenFirewallGroupRule(r *govultr.FirewallRule) map[string]interface{} {
+	return map[string]interface{}{
+		"ip_type":     r.IPType,
+		"protocol":    r.Protocol,
+		"subnet":      r.Subnet,
+		"subnet_size": r.SubnetSize,
+		"port":        r.Port,
+		"source":      r.Source,
+		"notes":       r.Notes,
+	}
+}
+
+// hashFirewallGroupRule hashes a rule by its content, so rules that only
+// differ in how the subnet is written are the same element of the set
+func hashFirewallGroupRule(v interface{}) int {
+	return schema.HashString(firewallGroupRuleKey(v.(map[string]interface{})))
+}
+
+// firewallGroupRuleKey identifies a rule by its content, with the subnet in
+// canonical form so 2001:db8:0::0 and 2001:db8:: are the same rule. The
+// subnet of a rule with a source is left out, since the source decides where
+// traffic may come from.
+func firewallGroupRuleKey(r map[string]interface{}) string {
+	subnet := fmt.Sprintf("%s/%v", canonicalizeIP(r["subnet"]), r["subnet_size"])
+	if r["source"] != "" {
+		subnet = ""
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%s", r["ip_type"], r["protocol"], subnet, r["port"], r["source"], r["notes"])
+}
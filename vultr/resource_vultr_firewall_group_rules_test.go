@@ -0,0 +1,113 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrFirewallGroupRulesBasic(t *testing.T) {
+	rString := acctest.RandString(13)
+	name := "vultr_firewall_group_rules.rules"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrFirewallGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrFirewallGroupRulesConfig(rString, "22"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "firewall_group_id", "vultr_firewall_group.fwg", "id"),
+					resource.TestCheckResourceAttr(name, "rule.#", "3"),
+				),
+			},
+			{
+				Config: testAccVultrFirewallGroupRulesConfig(rString, "2222"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rule.#", "3"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVultrFirewallGroupRulesAllow(t *testing.T) {
+	rString := acctest.RandString(13)
+	name := "vultr_firewall_group_rules.rules"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrFirewallGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrFirewallGroupRulesAllow(rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "allow.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVultrFirewallGroupRulesConfig(name, sshPort string) string {
+	return fmt.Sprintf(`
+		resource "vultr_firewall_group" "fwg" {
+			description = "%s"
+		}
+
+		resource "vultr_firewall_group_rules" "rules" {
+			firewall_group_id = vultr_firewall_group.fwg.id
+
+			rule {
+				ip_type     = "v4"
+				protocol    = "tcp"
+				subnet      = "10.0.0.0"
+				subnet_size = 8
+				port        = "%s"
+				notes       = "ssh"
+			}
+
+			rule {
+				ip_type     = "v6"
+				protocol    = "tcp"
+				subnet      = "::"
+				subnet_size = 0
+				port        = "443"
+			}
+
+			rule {
+				ip_type     = "v4"
+				protocol    = "icmp"
+				subnet      = "0.0.0.0"
+				subnet_size = 0
+			}
+		}`, name, sshPort)
+}
+
+func testAccVultrFirewallGroupRulesAllow(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_firewall_group" "fwg" {
+			description = "%s"
+		}
+
+		resource "vultr_firewall_group_rules" "rules" {
+			firewall_group_id = vultr_firewall_group.fwg.id
+
+			allow {
+				protocol = "tcp"
+				port     = "8000:8080"
+				cidrs    = ["192.0.2.0/24", "198.51.100.0/24", "2001:db8::/32"]
+				notes    = "offices"
+			}
+		}`, name)
+}
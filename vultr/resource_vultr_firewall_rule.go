@@ -54,10 +54,11 @@ func resourceVultrFirewallRule() *schema.Resource {
 				ForceNew: true,
 			},
 			"port": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validatePortOrPortRange),
 			},
 			"notes": {
 				Type:     schema.TypeString,
@@ -114,7 +115,7 @@ func resourceVultrFirewallRuleRead(ctx context.Context, d *schema.ResourceData,
 	ruleID, _ := strconv.Atoi(d.Id())
 	fw, _, err := client.FirewallRule.Get(ctx, d.Get("firewall_group_id").(string), ruleID)
 	if err != nil {
-		if strings.Contains(err.Error(), "Firewall rule ID not found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx,
 				fmt.Sprintf(
 					"Removing firewall rule ID (%s) in group (%s) because it is gone",
@@ -3,7 +3,6 @@ package vultr
 import (
 	"context"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,7 +65,7 @@ func resourceVultrInferenceRead(ctx context.Context, d *schema.ResourceData, met
 
 	inferenceSub, _, err := client.Inference.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid inference ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing inference subscription (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
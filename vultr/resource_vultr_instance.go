@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +23,7 @@ func resourceVultrInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrInstanceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -149,6 +149,7 @@ hostname on UI or API issues a reinstall of the OS.`,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Default:  nil,
 			},
+			"tags_all": tagsAllSchema(),
 			"reserved_ip_id": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -422,6 +423,7 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 			req.Tags = append(req.Tags, v.(string))
 		}
 	}
+	req.Tags = meta.(*Client).mergeDefaultTags(req.Tags)
 
 	if vpcIDs, vpcOK := d.GetOk("vpc_ids"); vpcOK {
 		for _, v := range vpcIDs.(*schema.Set).List() {
@@ -453,11 +455,15 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 			return nil
 		}
 
-		if strings.Contains(err.Error(), "Floating IPv4 address is already attached to another server") {
+		if classifyAPIError(err) == apiErrorRateLimited {
+			return retry.RetryableError(fmt.Errorf("creating instance was rate limited: %s", err.Error()))
+		}
+
+		if apiErrorContains(err, "Floating IPv4 address is already attached to another server") {
 			return retry.RetryableError(fmt.Errorf("cannot create instance with reserved IP: %s", err.Error()))
 		}
 
-		if strings.Contains(err.Error(), "Block storage is already attached to another server") {
+		if apiErrorContains(err, "Block storage is already attached to another server") {
 			return retry.RetryableError(fmt.Errorf("cannot create instance with block storage: %s", err.Error()))
 		}
 
@@ -465,7 +471,7 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	})
 
 	if retryErr != nil {
-		return diag.Errorf("error creating server: %v", retryErr)
+		return apiErrorDiag(retryErr, resourceVultrInstance().Schema, "error creating server")
 	}
 
 	d.SetId(instance.ID)
@@ -497,12 +503,7 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 
 	instance, _, err := client.Instance.Get(ctx, d.Id())
 	if err != nil {
-		missing, missErr := checkIsMissing(err, "instance not found")
-		if missErr != nil {
-			return diag.Errorf("error in api response %q : %v", err, missErr)
-		}
-
-		if missing {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing instance (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -562,9 +563,12 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("v6_network_size", instance.V6NetworkSize); err != nil {
 		return diag.Errorf("unable to set resource instance `v6_network_size` read value: %v", err)
 	}
-	if err := d.Set("tags", instance.Tags); err != nil {
+	if err := d.Set("tags", meta.(*Client).resourceTags(d, instance.Tags)); err != nil {
 		return diag.Errorf("unable to set resource instance `tags` read value: %v", err)
 	}
+	if err := d.Set("tags_all", instance.Tags); err != nil {
+		return diag.Errorf("unable to set resource instance `tags_all` read value: %v", err)
+	}
 	if err := d.Set("firewall_group_id", instance.FirewallGroupID); err != nil {
 		return diag.Errorf("unable to set resource instance `firewall_group_id` read value: %v", err)
 	}
@@ -729,13 +733,13 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		req.DetachVPC2 = append(req.DetachVPC2, diffSlice(newIDs, oldIDs)...) //nolint:staticcheck
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		_, newTags := tfChangeToSlices("tags", d)
-		req.Tags = newTags
+		req.Tags = meta.(*Client).mergeDefaultTags(newTags)
 	}
 
 	if _, _, err := client.Instance.Update(ctx, d.Id(), req); err != nil {
-		return diag.Errorf("error updating instance %s : %s", d.Id(), err.Error())
+		return apiErrorDiag(err, resourceVultrInstance().Schema, "error updating instance %s", d.Id())
 	}
 
 	if d.HasChange("iso_id") {
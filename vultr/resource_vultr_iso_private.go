@@ -2,7 +2,6 @@ package vultr
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net"
@@ -85,7 +84,7 @@ func resourceVultrIsoRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	iso, _, err := client.ISO.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains("Invalid iso", err.Error()) {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing ISO (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -121,33 +120,12 @@ func resourceVultrIsoDelete(ctx context.Context, d *schema.ResourceData, meta in
 	log.Printf("[INFO] Deleting iso : %s", d.Id())
 
 	if err := client.ISO.Delete(ctx, d.Id()); err != nil {
-		// decode the error
-		var attachedErr struct {
-			Error  string `json:"error"`
-			Status int    `json:"status"`
-		}
-
-		if unmarshalError := json.Unmarshal([]byte(err.Error()), &attachedErr); unmarshalError != nil {
-			return diag.Errorf(
-				"error deleting ISO %s: parsing error %s in deleting ISO : %v",
-				d.Id(),
-				err.Error(),
-				unmarshalError,
-			)
-		}
-
-		if !strings.Contains(attachedErr.Error, "is still attached to") {
-			return diag.Errorf(
-				"error deleting ISO %s: delete ISO error not related to attachment: delete error %+v",
-				d.Id(),
-				attachedErr,
-			)
-		}
-
-		parts := strings.Split(attachedErr.Error, " ")
+		// an ISO attached to an instance fails with the main IP of the
+		// instance at the end of the message
+		parts := strings.Split(parseAPIError(err).Message, " ")
 		ip := parts[len(parts)-1]
-		if parsedIP := net.ParseIP(ip); parsedIP == nil {
-			return diag.Errorf("error deleting ISO %s : failed to parse IP to which ISO is attached: %s", d.Id(), ip)
+		if classifyAPIError(err) != apiErrorValidation || net.ParseIP(ip) == nil {
+			return diag.Errorf("error deleting ISO %s : %v", d.Id(), err)
 		}
 
 		// default is 100 instances
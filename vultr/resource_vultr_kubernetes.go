@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +23,7 @@ func resourceVultrKubernetes() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrKubernetesCustomizeDiff,
 		Schema:        resourceVultrKubernetesV1(),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -99,7 +99,7 @@ func resourceVultrKubernetesCreate(ctx context.Context, d *schema.ResourceData,
 
 	cluster, _, err := client.Kubernetes.CreateCluster(ctx, req)
 	if err != nil {
-		return diag.Errorf("error creating kubernetes cluster: %v", err)
+		return apiErrorDiag(err, resourceVultrKubernetes().Schema, "error creating kubernetes cluster")
 	}
 
 	d.SetId(cluster.ID)
@@ -167,10 +167,10 @@ func resourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData, me
 
 	vke, _, err := client.Kubernetes.GetCluster(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Unauthorized") {
+		if isUnauthorizedError(err) {
 			return diag.Errorf("API authorization error: %v", err)
 		}
-		if strings.Contains(err.Error(), "Invalid resource ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Kubernetes Cluster (%v) not found", d.Id())
 			d.SetId("")
 			return nil
@@ -325,7 +325,7 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err := client.Kubernetes.UpdateCluster(ctx, d.Id(), req); err != nil {
-		return diag.Errorf("error updating vke cluster (%v): %v", d.Id(), err)
+		return apiErrorDiag(err, resourceVultrKubernetes().Schema, "error updating vke cluster (%v)", d.Id())
 	}
 
 	if d.HasChange("node_pools") {
@@ -358,7 +358,7 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if _, _, err := client.Kubernetes.UpdateNodePool(ctx, d.Id(), newNodePoolData["id"].(string), req); err != nil {
-			return diag.Errorf("error updating vke node pool %v : %v", d.Id(), err)
+			return apiErrorDiag(err, resourceVultrKubernetes().Schema, "error updating vke node pool %v", d.Id())
 		}
 
 		if d.HasChange("node_pools.0.labels") {
@@ -389,7 +389,7 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if err := client.Kubernetes.Upgrade(ctx, d.Id(), upgradeReq); err != nil {
-			return diag.Errorf("error upgrading VKE cluster %v : %v", d.Id(), err)
+			return apiErrorDiag(err, resourceVultrKubernetes().Schema, "error upgrading VKE cluster %v", d.Id())
 		}
 	}
 
@@ -35,6 +35,7 @@ func resourceVultrKubernetesNodePools() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: resourceVultrKubernetesNodePoolsCustomizeDiff,
 		Schema:        resourceVultrKubernetesNodePoolsV1(true),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -136,7 +137,7 @@ func resourceVultrKubernetesNodePoolsCreate(ctx context.Context, d *schema.Resou
 
 	nodePool, _, err := client.Kubernetes.CreateNodePool(ctx, clusterID, req)
 	if err != nil {
-		return diag.Errorf("error creating node pool: %v", err)
+		return apiErrorDiag(err, resourceVultrKubernetesNodePools().Schema, "error creating node pool")
 	}
 
 	d.SetId(nodePool.ID)
@@ -209,10 +210,10 @@ func resourceVultrKubernetesNodePoolsRead(ctx context.Context, d *schema.Resourc
 
 	nodePool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Unauthorized") {
+		if isUnauthorizedError(err) {
 			return diag.Errorf("API authorization error: %v", err)
 		}
-		if strings.Contains(err.Error(), "Invalid NodePool ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Kubernetes NodePool (%v) not found", d.Id())
 			d.SetId("")
 			return nil
@@ -335,7 +336,7 @@ func resourceVultrKubernetesNodePoolsUpdate(ctx context.Context, d *schema.Resou
 	}
 
 	if _, _, err := client.Kubernetes.UpdateNodePool(ctx, clusterID, d.Id(), req); err != nil {
-		return diag.Errorf("error updating VKE node pool %v : %v", d.Id(), err)
+		return apiErrorDiag(err, resourceVultrKubernetesNodePools().Schema, "error updating VKE node pool %v", d.Id())
 	}
 
 	if d.HasChange("labels") {
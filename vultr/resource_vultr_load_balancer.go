@@ -21,9 +21,14 @@ func resourceVultrLoadBalancer() *schema.Resource {
 		ReadContext:   resourceVultrLoadBalancerRead,
 		UpdateContext: resourceVultrLoadBalancerUpdate,
 		DeleteContext: resourceVultrLoadBalancerDelete,
+		CustomizeDiff: resourceVultrLoadBalancerCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Update: schema.DefaultTimeout(1 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:             schema.TypeString,
@@ -206,6 +211,12 @@ func resourceVultrLoadBalancer() *schema.Resource {
 				},
 			},
 
+			"allow_external_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"has_ssl": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -220,6 +231,11 @@ func resourceVultrLoadBalancer() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_ready_backends": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -345,7 +361,7 @@ func resourceVultrLoadBalancerCreate(ctx context.Context, d *schema.ResourceData
 
 	lb, _, err := client.LoadBalancer.Create(ctx, req)
 	if err != nil {
-		return diag.Errorf("error creating load balancer: %v", err)
+		return apiErrorDiag(err, resourceVultrLoadBalancer().Schema, "error creating load balancer")
 	}
 	d.SetId(lb.ID)
 
@@ -357,6 +373,10 @@ func resourceVultrLoadBalancerCreate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] load balancer ID: %v", lb.ID)
 
+	if err := waitForLBReadyBackends(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error while waiting for load balancer %v backends to be ready: %v", lb.ID, err)
+	}
+
 	return resourceVultrLoadBalancerRead(ctx, d, meta)
 }
 
@@ -365,11 +385,21 @@ func resourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData,
 
 	lb, _, err := client.LoadBalancer.Get(ctx, d.Id())
 	if err != nil {
-		log.Printf("[WARN] Vultr load balancer (%v) not found", d.Id())
-		d.SetId("")
-		return nil
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Vultr load balancer (%v) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting load balancer (%s): %v", d.Id(), err)
 	}
 
+	// with allow_external_rules only the configured rules are read, so the
+	// rules of vultr_load_balancer_forwarding_rule and
+	// vultr_load_balancer_firewall_rule resources are left out
+	shared := d.Get("allow_external_rules").(bool)
+	managedFR := lbRuleKeys(d.Get("forwarding_rules").(*schema.Set), lbForwardingRuleKey)
+	managedFWR := lbRuleKeys(d.Get("firewall_rules").(*schema.Set), lbFirewallRuleKey)
+
 	var rulesList []map[string]interface{}
 	for _, rules := range lb.ForwardingRules {
 		rule := map[string]interface{}{
@@ -379,9 +409,15 @@ func resourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData,
 			"backend_protocol":  rules.BackendProtocol,
 			"backend_port":      rules.BackendPort,
 		}
+		if shared && !managedFR[lbForwardingRuleKey(rule)] {
+			continue
+		}
 		rulesList = append(rulesList, rule)
 	}
 
+	if err := d.Set("allow_external_rules", shared); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `allow_external_rules` read value: %v", err)
+	}
 	if err := d.Set("forwarding_rules", rulesList); err != nil {
 		return diag.Errorf("unable to set resource load_balancer `forwarding_rules` read value: %v", err)
 	}
@@ -394,6 +430,9 @@ func resourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData,
 			"ip_type": rules.IPType,
 			"port":    rules.Port,
 		}
+		if shared && !managedFWR[lbFirewallRuleKey(rule)] {
+			continue
+		}
 		fwrList = append(fwrList, rule)
 	}
 
@@ -534,7 +573,8 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 			}
 		}
 	}
-	if d.HasChange("forwarding_rules") {
+	shared := d.Get("allow_external_rules").(bool)
+	if d.HasChange("forwarding_rules") && !shared {
 		_, newFR := d.GetChange("forwarding_rules")
 
 		var rules []govultr.ForwardingRule
@@ -552,7 +592,7 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 		req.ForwardingRules = rules
 	}
 
-	if d.HasChange("firewall_rules") {
+	if d.HasChange("firewall_rules") && !shared {
 		_, newFWR := d.GetChange("firewall_rules")
 
 		fwList := newFWR.(*schema.Set).List()
@@ -608,7 +648,27 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if err := client.LoadBalancer.Update(ctx, d.Id(), req); err != nil {
-		return diag.Errorf("error updating load balancer generic info (%v): %v", d.Id(), err)
+		return apiErrorDiag(err, resourceVultrLoadBalancer().Schema, "error updating load balancer generic info (%v)", d.Id())
+	}
+
+	if shared {
+		if err := updateLoadBalancerRules(ctx, client, d); err != nil {
+			return diag.Errorf("error updating load balancer rules (%v): %v", d.Id(), err)
+		}
+	} else if d.HasChange("firewall_rules") && len(req.FirewallRules) == 0 {
+		// an empty list is left out of the request, so the rules are
+		// removed one at a time
+		oldFWR, _ := d.GetChange("firewall_rules")
+		for _, v := range oldFWR.(*schema.Set).List() {
+			id := v.(map[string]interface{})["id"].(string)
+			if err := client.LoadBalancer.DeleteFirewallRule(ctx, d.Id(), id); err != nil && !isNotFoundError(err) {
+				return diag.Errorf("error deleting load balancer firewall rule %s (%v): %v", id, d.Id(), err)
+			}
+		}
+	}
+
+	if err := waitForLBReadyBackends(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error while waiting for load balancer %v backends to be ready: %v", d.Id(), err)
 	}
 
 	return resourceVultrLoadBalancerRead(ctx, d, meta)
@@ -637,7 +697,7 @@ func resourceVultrLoadBalancerDelete(ctx context.Context, d *schema.ResourceData
 	if err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
 		err := client.LoadBalancer.Delete(ctx, d.Id())
 		if err != nil {
-			if strings.Contains(err.Error(), "Load balancer is not ready.") {
+			if isConflictError(err) || isRetryableError(err) || apiErrorContains(err, "Load balancer is not ready.") {
 				return retry.RetryableError(fmt.Errorf("deleting load balancer failed with retryable error: %s", err))
 			} else {
 				return retry.NonRetryableError(fmt.Errorf("deleting load balancer failed with non-retryable error: %s", err))
@@ -659,7 +719,7 @@ func waitForLBAvailable(ctx context.Context, d *schema.ResourceData, target stri
 		Pending:        pending,
 		Target:         []string{target},
 		Refresh:        newLBStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          10 * time.Second,
 		MinTimeout:     5 * time.Second,
 		NotFoundChecks: 60,
@@ -687,6 +747,164 @@ func newLBStateRefresh(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 }
 
+// resourceVultrLoadBalancerCustomizeDiff checks at plan time that
+// wait_for_ready_backends can be met by the configured attached_instances.
+// Instances attached by a vultr_load_balancer_attachment are attached after
+// the load balancer is created, so they can't be waited for.
+func resourceVultrLoadBalancerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	want := d.Get("wait_for_ready_backends").(int)
+	if want == 0 {
+		return nil
+	}
+
+	if raw := d.GetRawConfig(); !raw.IsNull() && raw.GetAttr("attached_instances").IsNull() {
+		return fmt.Errorf("wait_for_ready_backends requires attached_instances to be set")
+	}
+	if !d.NewValueKnown("attached_instances") {
+		return nil
+	}
+	if n := len(d.Get("attached_instances").([]interface{})); n < want {
+		return fmt.Errorf("wait_for_ready_backends is %d but only %d attached_instances are set", want, n)
+	}
+
+	return nil
+}
+
+// waitForLBReadyBackends blocks until wait_for_ready_backends of the attached
+// instances are ready. It fails right away when fewer instances are attached,
+// since the wait could never end.
+func waitForLBReadyBackends(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error { //nolint:lll
+	want := d.Get("wait_for_ready_backends").(int)
+	if want == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Waiting for load balancer (%s) to have %d ready backends", d.Id(), want)
+
+	client := meta.(*Client).govultrClient()
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"waiting"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			lb, _, err := client.LoadBalancer.Get(ctx, d.Id())
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving lb %s : %v", d.Id(), err)
+			}
+			if len(lb.Instances) < want {
+				return nil, "", fmt.Errorf("load balancer has %d attached instances, fewer than the %d to wait for",
+					len(lb.Instances), want)
+			}
+
+			_, ready, err := loadBalancerBackends(ctx, client, lb)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[INFO] The load balancer has %d of %d ready backends", ready, want)
+			if ready < want {
+				return lb, "waiting", nil
+			}
+			return lb, "ready", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// updateLoadBalancerRules adds and removes the changed rules one at a time,
+// leaving the rules of other configurations alone. Turning on
+// allow_external_rules removes nothing, since the rules read before, such as
+// on import, include those of other configurations.
+func updateLoadBalancerRules(ctx context.Context, client *govultr.Client, d *schema.ResourceData) error {
+	wasShared, _ := d.GetChange("allow_external_rules")
+	remove := wasShared.(bool)
+
+	oldFR, newFR := d.GetChange("forwarding_rules")
+	added, removed := lbRuleChanges(oldFR.(*schema.Set), newFR.(*schema.Set), lbForwardingRuleKey)
+	for _, r := range removed {
+		if id := r["rule_id"].(string); remove && id != "" {
+			if err := client.LoadBalancer.DeleteForwardingRule(ctx, d.Id(), id); err != nil && !isNotFoundError(err) {
+				return fmt.Errorf("error deleting forwarding rule %s : %v", id, err)
+			}
+		}
+	}
+	for _, r := range added {
+		rule := &govultr.ForwardingRule{
+			FrontendProtocol: r["frontend_protocol"].(string),
+			FrontendPort:     r["frontend_port"].(int),
+			BackendProtocol:  r["backend_protocol"].(string),
+			BackendPort:      r["backend_port"].(int),
+		}
+		if _, _, err := client.LoadBalancer.CreateForwardingRule(ctx, d.Id(), rule); err != nil {
+			return fmt.Errorf("error creating forwarding rule: %v", err)
+		}
+	}
+
+	oldFWR, newFWR := d.GetChange("firewall_rules")
+	added, removed = lbRuleChanges(oldFWR.(*schema.Set), newFWR.(*schema.Set), lbFirewallRuleKey)
+	for _, r := range removed {
+		if id := r["id"].(string); remove && id != "" {
+			if err := client.LoadBalancer.DeleteFirewallRule(ctx, d.Id(), id); err != nil && !isNotFoundError(err) {
+				return fmt.Errorf("error deleting firewall rule %s : %v", id, err)
+			}
+		}
+	}
+	if len(added) != 0 {
+		var rules []govultr.LBFirewallRule
+		for _, r := range added {
+			rules = append(rules, govultr.LBFirewallRule{
+				Port:   r["port"].(int),
+				Source: r["source"].(string),
+				IPType: r["ip_type"].(string),
+			})
+		}
+		if err := client.LoadBalancer.CreateFirewallRules(ctx, d.Id(), rules); err != nil {
+			return fmt.Errorf("error creating firewall rules: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// lbRuleChanges returns the rules only in the new set and those only in the
+// old set, comparing them by key so that computed IDs don't count
+func lbRuleChanges(oldRules, newRules *schema.Set, key func(map[string]interface{}) string) (added, removed []map[string]interface{}) { //nolint:lll
+	oldKeys, newKeys := lbRuleKeys(oldRules, key), lbRuleKeys(newRules, key)
+	for _, v := range newRules.List() {
+		if r := v.(map[string]interface{}); !oldKeys[key(r)] {
+			added = append(added, r)
+		}
+	}
+	for _, v := range oldRules.List() {
+		if r := v.(map[string]interface{}); !newKeys[key(r)] {
+			removed = append(removed, r)
+		}
+	}
+
+	return added, removed
+}
+
+func lbRuleKeys(rules *schema.Set, key func(map[string]interface{}) string) map[string]bool {
+	keys := map[string]bool{}
+	for _, v := range rules.List() {
+		keys[key(v.(map[string]interface{}))] = true
+	}
+
+	return keys
+}
+
+func lbForwardingRuleKey(r map[string]interface{}) string {
+	return fmt.Sprintf("%v:%v:%v:%v", r["frontend_protocol"], r["frontend_port"], r["backend_protocol"], r["backend_port"])
+}
+
+func lbFirewallRuleKey(r map[string]interface{}) string {
+	return fmt.Sprintf("%v:%v:%v", r["ip_type"], r["source"], r["port"])
+}
+
 func generateRules(rules interface{}) *govultr.ForwardingRules {
 	fwMap := &govultr.ForwardingRules{}
 	for _, rule := range rules.(*schema.Set).List() {
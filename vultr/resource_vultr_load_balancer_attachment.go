@@ -0,0 +1,334 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// loadBalancerInstanceLocks serializes changes to the instance list of a load
+// balancer, since the API only takes the whole list and attachments to the
+// same load balancer are applied in parallel
+var loadBalancerInstanceLocks sync.Map
+
+// resourceVultrLoadBalancerAttachment attaches a single instance, or every
+// node of a kubernetes node pool, to a load balancer while leaving the other
+// instances of the load balancer alone. Node pool attachments follow the
+// nodes of the pool as it scales or replaces them, and can move to another
+// pool in place.
+func resourceVultrLoadBalancerAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerAttachmentCreate,
+		ReadContext:   resourceVultrLoadBalancerAttachmentRead,
+		UpdateContext: resourceVultrLoadBalancerAttachmentUpdate,
+		DeleteContext: resourceVultrLoadBalancerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrLoadBalancerAttachmentImport,
+		},
+		CustomizeDiff: resourceVultrLoadBalancerAttachmentCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"instance_id", "node_pool_id"},
+			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				RequiredWith: []string{"node_pool_id"},
+			},
+			"node_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				RequiredWith: []string{"cluster_id"},
+			},
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVultrLoadBalancerAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	ids, err := loadBalancerAttachmentInstances(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Attaching %s to load balancer %s", strings.Join(ids, ", "), lbID)
+	if err := updateLoadBalancerInstances(ctx, client, lbID, ids, nil); err != nil {
+		return diag.Errorf("error attaching instances to load balancer %s : %v", lbID, err)
+	}
+
+	if instanceID := d.Get("instance_id").(string); instanceID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", lbID, instanceID))
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s/%s", lbID, d.Get("cluster_id"), d.Get("node_pool_id")))
+	}
+
+	return resourceVultrLoadBalancerAttachmentRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	lb, _, err := client.LoadBalancer.Get(ctx, lbID)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Load balancer %s not found, removing attachment %s from state", lbID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting load balancer %s : %v", lbID, err)
+	}
+
+	attached := map[string]bool{}
+	for _, id := range lb.Instances {
+		attached[id] = true
+	}
+
+	var ids []string
+	if instanceID := d.Get("instance_id").(string); instanceID != "" {
+		if !attached[instanceID] {
+			log.Printf("[WARN] Instance %s is no longer attached to load balancer %s, removing from state", instanceID, lbID)
+			d.SetId("")
+			return nil
+		}
+		ids = []string{instanceID}
+	} else {
+		nodes, err := loadBalancerAttachmentInstances(ctx, client, d)
+		if err != nil {
+			if isNotFoundError(err) {
+				log.Printf("[WARN] Node pool %s not found, removing attachment %s from state", d.Get("node_pool_id"), d.Id())
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		// keep the nodes that left the pool but are still attached, so the
+		// next apply detaches them
+		for _, id := range append(nodes, expandStringSet(d.Get("instance_ids"))...) {
+			if attached[id] {
+				ids = append(ids, id)
+				delete(attached, id)
+			}
+		}
+	}
+
+	if err := d.Set("instance_ids", ids); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_attachment `instance_ids` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	ids, err := loadBalancerAttachmentInstances(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldIDs, _ := d.GetChange("instance_ids")
+	log.Printf("[INFO] Updating instances of load balancer %s attached by %s", lbID, d.Id())
+	if err := updateLoadBalancerInstances(ctx, client, lbID, ids, expandStringSet(oldIDs)); err != nil {
+		return diag.Errorf("error updating instances attached to load balancer %s : %v", lbID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, d.Get("cluster_id"), d.Get("node_pool_id")))
+
+	return resourceVultrLoadBalancerAttachmentRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	ids := expandStringSet(d.Get("instance_ids"))
+	log.Printf("[INFO] Detaching %s from load balancer %s", strings.Join(ids, ", "), lbID)
+	if err := updateLoadBalancerInstances(ctx, client, lbID, nil, ids); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("error detaching instances from load balancer %s : %v", lbID, err)
+	}
+
+	return nil
+}
+
+// resourceVultrLoadBalancerAttachmentCustomizeDiff plans an update when the
+// nodes of the node pool no longer match the attached instances, either
+// because the pool changed its nodes or the attachment moved to another pool
+func resourceVultrLoadBalancerAttachmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error { //nolint:lll
+	if d.Id() == "" || d.Get("instance_id").(string) != "" || d.HasChange("load_balancer_id") {
+		return nil
+	}
+	if !d.NewValueKnown("cluster_id") || !d.NewValueKnown("node_pool_id") {
+		return d.SetNewComputed("instance_ids")
+	}
+
+	clusterID, poolID := d.Get("cluster_id").(string), d.Get("node_pool_id").(string)
+	pool, _, err := meta.(*Client).govultrClient().Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting node pool %s of kubernetes cluster %s : %v", poolID, clusterID, err)
+	}
+
+	nodes := make([]string, 0, len(pool.Nodes))
+	for i := range pool.Nodes {
+		nodes = append(nodes, pool.Nodes[i].ID)
+	}
+
+	current := expandStringSet(d.Get("instance_ids"))
+	sort.Strings(nodes)
+	sort.Strings(current)
+	if strings.Join(nodes, ",") == strings.Join(current, ",") {
+		return nil
+	}
+
+	return d.SetNew("instance_ids", nodes)
+}
+
+// resourceVultrLoadBalancerAttachmentImport reads the lbID/instanceID or
+// lbID/clusterID/nodePoolID import ID of an attachment
+func resourceVultrLoadBalancerAttachmentImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	ids := strings.Split(d.Id(), "/")
+	for _, id := range ids {
+		if id == "" {
+			ids = nil
+			break
+		}
+	}
+
+	values := map[string]string{}
+	switch len(ids) {
+	case 2: //nolint:mnd
+		values["instance_id"] = ids[1]
+	case 3: //nolint:mnd
+		values["cluster_id"], values["node_pool_id"] = ids[1], ids[2]
+	default:
+		return nil, fmt.Errorf(
+			"unexpected format of load balancer attachment import ID (%s): "+
+				"expected 'lbID/instanceID' or 'lbID/clusterID/nodePoolID'",
+			d.Id(),
+		)
+	}
+	values["load_balancer_id"] = ids[0]
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return nil, fmt.Errorf("unable to set %s for import state function: %v", k, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// loadBalancerAttachmentInstances returns the instance of an attachment, or
+// the current nodes of its node pool
+func loadBalancerAttachmentInstances(ctx context.Context, client *govultr.Client, d *schema.ResourceData) ([]string, error) { //nolint:lll
+	if instanceID := d.Get("instance_id").(string); instanceID != "" {
+		return []string{instanceID}, nil
+	}
+
+	clusterID, poolID := d.Get("cluster_id").(string), d.Get("node_pool_id").(string)
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting node pool %s of kubernetes cluster %s : %w", poolID, clusterID, err)
+	}
+
+	ids := make([]string, 0, len(pool.Nodes))
+	for i := range pool.Nodes {
+		ids = append(ids, pool.Nodes[i].ID)
+	}
+
+	return ids, nil
+}
+
+// updateLoadBalancerInstances adds and removes instances of a load balancer,
+// keeping the instances attached by anything else. LoadBalancerReq leaves out
+// an empty instance list, so the request is made directly.
+func updateLoadBalancerInstances(ctx context.Context, client *govultr.Client, lbID string, add, remove []string) error {
+	lock, _ := loadBalancerInstanceLocks.LoadOrStore(lbID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	lb, _, err := client.LoadBalancer.Get(ctx, lbID)
+	if err != nil {
+		return err
+	}
+
+	drop := map[string]bool{}
+	for _, id := range remove {
+		drop[id] = true
+	}
+	for _, id := range add {
+		delete(drop, id)
+	}
+
+	instances := []string{}
+	seen := map[string]bool{}
+	for _, id := range lb.Instances {
+		if !drop[id] && !seen[id] {
+			seen[id] = true
+			instances = append(instances, id)
+		}
+	}
+	changed := len(instances) != len(lb.Instances)
+	for _, id := range add {
+		if !seen[id] {
+			seen[id] = true
+			instances = append(instances, id)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("/v2/load-balancers/%s", lbID),
+		map[string][]string{"instances": instances})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoWithContext(ctx, req, nil)
+	return err
+}
+
+func expandStringSet(v interface{}) []string {
+	var ids []string
+	for _, id := range v.(*schema.Set).List() {
+		ids = append(ids, id.(string))
+	}
+
+	return ids
+}
@@ -0,0 +1,70 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrLoadBalancerAttachmentBasic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-lb-attach")
+	name := "vultr_load_balancer_attachment.web"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerAttachmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "instance_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "instance_id", "vultr_instance.web", "id"),
+					resource.TestCheckResourceAttr("data.vultr_load_balancer.lb", "attached_instances.#", "1"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVultrLoadBalancerAttachmentConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "lb" {
+			region = "ewr"
+			label  = "%[1]s"
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		resource "vultr_instance" "web" {
+			plan   = "vc2-1c-1gb"
+			region = "ewr"
+			os_id  = 1743
+			label  = "%[1]s"
+		}
+
+		resource "vultr_load_balancer_attachment" "web" {
+			load_balancer_id = vultr_load_balancer.lb.id
+			instance_id      = vultr_instance.web.id
+		}
+
+		data "vultr_load_balancer" "lb" {
+			filter {
+				name   = "label"
+				values = [vultr_load_balancer.lb.label]
+			}
+			depends_on = [vultr_load_balancer_attachment.web]
+		}`, name)
+}
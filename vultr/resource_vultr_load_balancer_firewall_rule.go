@@ -0,0 +1,153 @@
+package vultr
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrLoadBalancerFirewallRule manages a single firewall rule of a
+// load balancer, so rules can be added from other configurations than the one
+// holding the load balancer. Rules can't be changed in place.
+func resourceVultrLoadBalancerFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerFirewallRuleCreate,
+		ReadContext:   resourceVultrLoadBalancerFirewallRuleRead,
+		DeleteContext: resourceVultrLoadBalancerFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrLoadBalancerRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+			"ip_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"v4", "v6"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceVultrLoadBalancerFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	req := govultr.LBFirewallRule{
+		Port:   d.Get("port").(int),
+		IPType: d.Get("ip_type").(string),
+		Source: d.Get("source").(string),
+	}
+
+	// creating firewall rules doesn't return them, so the new rule is the one
+	// matching the request that wasn't there before
+	before, err := listLoadBalancerFirewallRules(ctx, client, lbID)
+	if err != nil {
+		return diag.Errorf("error getting firewall rules of load balancer %s : %v", lbID, err)
+	}
+	existing := map[string]bool{}
+	for _, r := range before {
+		existing[r.RuleID] = true
+	}
+
+	log.Printf("[INFO] Creating firewall rule for load balancer %s", lbID)
+	if err := client.LoadBalancer.CreateFirewallRules(ctx, lbID, []govultr.LBFirewallRule{req}); err != nil {
+		return diag.Errorf("error creating firewall rule for load balancer %s : %v", lbID, err)
+	}
+
+	after, err := listLoadBalancerFirewallRules(ctx, client, lbID)
+	if err != nil {
+		return diag.Errorf("error getting firewall rules of load balancer %s : %v", lbID, err)
+	}
+	for _, r := range after {
+		if !existing[r.RuleID] && r.Port == req.Port && r.IPType == req.IPType && r.Source == req.Source {
+			d.SetId(r.RuleID)
+			break
+		}
+	}
+	if d.Id() == "" {
+		return diag.Errorf("error creating firewall rule for load balancer %s : rule not found after creation", lbID)
+	}
+
+	return resourceVultrLoadBalancerFirewallRuleRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	rule, _, err := client.LoadBalancer.GetFirewallRule(ctx, lbID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Firewall rule %s of load balancer %s not found, removing from state", d.Id(), lbID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting firewall rule %s of load balancer %s : %v", d.Id(), lbID, err)
+	}
+
+	if err := d.Set("port", rule.Port); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_firewall_rule `port` read value: %v", err)
+	}
+	if err := d.Set("ip_type", rule.IPType); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_firewall_rule `ip_type` read value: %v", err)
+	}
+	if err := d.Set("source", rule.Source); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_firewall_rule `source` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	log.Printf("[INFO] Deleting firewall rule %s of load balancer %s", d.Id(), lbID)
+	if err := client.LoadBalancer.DeleteFirewallRule(ctx, lbID, d.Id()); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("error deleting firewall rule %s of load balancer %s : %v", d.Id(), lbID, err)
+	}
+
+	return nil
+}
+
+func listLoadBalancerFirewallRules(ctx context.Context, client *govultr.Client, lbID string) ([]govultr.LBFirewallRule, error) { //nolint:lll
+	var rules []govultr.LBFirewallRule
+
+	options := &govultr.ListOptions{}
+	for {
+		list, meta, _, err := client.LoadBalancer.ListFirewallRules(ctx, lbID, options)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, list...)
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,59 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrLoadBalancerFirewallRuleBasic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-lb-fw")
+	name := "vultr_load_balancer_firewall_rule.office"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerFirewallRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "port", "80"),
+					resource.TestCheckResourceAttr(name, "ip_type", "v4"),
+					resource.TestCheckResourceAttr(name, "source", "192.0.2.0/24"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVultrLoadBalancerRuleImportID(name),
+			},
+		},
+	})
+}
+
+func testAccVultrLoadBalancerFirewallRuleConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "lb" {
+			region               = "ewr"
+			label                = "%s"
+			allow_external_rules = true
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		resource "vultr_load_balancer_firewall_rule" "office" {
+			load_balancer_id = vultr_load_balancer.lb.id
+			port             = 80
+			ip_type          = "v4"
+			source           = "192.0.2.0/24"
+		}`, name)
+}
@@ -0,0 +1,139 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrLoadBalancerForwardingRule manages a single forwarding rule of
+// a load balancer, so rules can be added from other configurations than the
+// one holding the load balancer. Rules can't be changed in place.
+func resourceVultrLoadBalancerForwardingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerForwardingRuleCreate,
+		ReadContext:   resourceVultrLoadBalancerForwardingRuleRead,
+		DeleteContext: resourceVultrLoadBalancerForwardingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrLoadBalancerRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"frontend_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp"}, false),
+			},
+			"frontend_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+			"backend_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp"}, false),
+			},
+			"backend_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+		},
+	}
+}
+
+func resourceVultrLoadBalancerForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	req := &govultr.ForwardingRule{
+		FrontendProtocol: d.Get("frontend_protocol").(string),
+		FrontendPort:     d.Get("frontend_port").(int),
+		BackendProtocol:  d.Get("backend_protocol").(string),
+		BackendPort:      d.Get("backend_port").(int),
+	}
+
+	log.Printf("[INFO] Creating forwarding rule for load balancer %s", lbID)
+	rule, _, err := client.LoadBalancer.CreateForwardingRule(ctx, lbID, req)
+	if err != nil {
+		return diag.Errorf("error creating forwarding rule for load balancer %s : %v", lbID, err)
+	}
+
+	d.SetId(rule.RuleID)
+
+	return resourceVultrLoadBalancerForwardingRuleRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	rule, _, err := client.LoadBalancer.GetForwardingRule(ctx, lbID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Forwarding rule %s of load balancer %s not found, removing from state", d.Id(), lbID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting forwarding rule %s of load balancer %s : %v", d.Id(), lbID, err)
+	}
+
+	if err := d.Set("frontend_protocol", rule.FrontendProtocol); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `frontend_protocol` read value: %v", err)
+	}
+	if err := d.Set("frontend_port", rule.FrontendPort); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `frontend_port` read value: %v", err)
+	}
+	if err := d.Set("backend_protocol", rule.BackendProtocol); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `backend_protocol` read value: %v", err)
+	}
+	if err := d.Set("backend_port", rule.BackendPort); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `backend_port` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerForwardingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	log.Printf("[INFO] Deleting forwarding rule %s of load balancer %s", d.Id(), lbID)
+	if err := client.LoadBalancer.DeleteForwardingRule(ctx, lbID, d.Id()); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("error deleting forwarding rule %s of load balancer %s : %v", d.Id(), lbID, err)
+	}
+
+	return nil
+}
+
+// resourceVultrLoadBalancerRuleImport reads the lbID/ruleID import ID of the
+// load balancer forwarding and firewall rules
+func resourceVultrLoadBalancerRuleImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	ids := strings.SplitN(d.Id(), "/", 2)
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		return nil, fmt.Errorf("unexpected format of load balancer rule import ID (%s): expected 'lbID/ruleID'", d.Id())
+	}
+
+	d.SetId(ids[1])
+	if err := d.Set("load_balancer_id", ids[0]); err != nil {
+		return nil, fmt.Errorf("unable to set load_balancer_id for import state function: %v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,74 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrLoadBalancerForwardingRuleBasic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-lb-fr")
+	name := "vultr_load_balancer_forwarding_rule.api"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerForwardingRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "frontend_protocol", "tcp"),
+					resource.TestCheckResourceAttr(name, "frontend_port", "8443"),
+					resource.TestCheckResourceAttr(name, "backend_port", "443"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVultrLoadBalancerRuleImportID(name),
+			},
+		},
+	})
+}
+
+// testAccVultrLoadBalancerRuleImportID returns the lbID/ruleID import ID of
+// a load balancer rule resource
+func testAccVultrLoadBalancerRuleImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["load_balancer_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccVultrLoadBalancerForwardingRuleConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "lb" {
+			region               = "ewr"
+			label                = "%s"
+			allow_external_rules = true
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		resource "vultr_load_balancer_forwarding_rule" "api" {
+			load_balancer_id  = vultr_load_balancer.lb.id
+			frontend_protocol = "tcp"
+			frontend_port     = 8443
+			backend_protocol  = "tcp"
+			backend_port      = 443
+		}`, name)
+}
@@ -0,0 +1,247 @@
+package vultr
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// resourceVultrLoadBalancerSSL manages the certificate of a load balancer
+// apart from the load balancer itself. A new certificate replaces the old one
+// in a single update, so the load balancer keeps serving TLS throughout.
+func resourceVultrLoadBalancerSSL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerSSLCreate,
+		ReadContext:   resourceVultrLoadBalancerSSLRead,
+		UpdateContext: resourceVultrLoadBalancerSSLUpdate,
+		DeleteContext: resourceVultrLoadBalancerSSLDelete,
+		CustomizeDiff: resourceVultrLoadBalancerSSLCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"private_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"chain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVultrLoadBalancerSSLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	lbID := d.Get("load_balancer_id").(string)
+
+	log.Printf("[INFO] Adding SSL certificate to load balancer %s", lbID)
+	if err := updateLoadBalancerSSL(ctx, meta.(*Client).govultrClient(), d); err != nil {
+		return diag.Errorf("error adding SSL certificate to load balancer %s : %v", lbID, err)
+	}
+
+	d.SetId(lbID)
+
+	return resourceVultrLoadBalancerSSLRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerSSLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	lb, _, err := client.LoadBalancer.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Load balancer %s not found, removing its SSL certificate from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting load balancer %s : %v", d.Id(), err)
+	}
+
+	// the API never returns the certificate, only whether there is one
+	if lb.SSLInfo == nil || !*lb.SSLInfo {
+		log.Printf("[WARN] Load balancer %s no longer has an SSL certificate, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("load_balancer_id", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_ssl `load_balancer_id` read value: %v", err)
+	}
+
+	leaf, err := parseLoadBalancerCertificate(d.Get("certificate").(string), "", "")
+	if err != nil {
+		return diag.Errorf("error parsing load balancer %s certificate : %v", d.Id(), err)
+	}
+	for k, v := range flattenLoadBalancerCertificate(leaf) {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("unable to set resource load_balancer_ssl `%s` read value: %v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerSSLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	log.Printf("[INFO] Replacing SSL certificate of load balancer %s", d.Id())
+	if err := updateLoadBalancerSSL(ctx, meta.(*Client).govultrClient(), d); err != nil {
+		return diag.Errorf("error replacing SSL certificate of load balancer %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrLoadBalancerSSLRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerSSLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Removing SSL certificate from load balancer %s", d.Id())
+	if err := client.LoadBalancer.DeleteSSL(ctx, d.Id()); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("error removing SSL certificate from load balancer %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceVultrLoadBalancerSSLCustomizeDiff checks the PEM material at plan
+// time and shows the validity and names of a new certificate in the plan
+func resourceVultrLoadBalancerSSLCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && !d.HasChanges("certificate", "private_key", "chain") {
+		return nil
+	}
+
+	if !d.NewValueKnown("certificate") || !d.NewValueKnown("private_key") || !d.NewValueKnown("chain") {
+		for _, k := range []string{"not_before", "not_after", "subject_alternative_names", "fingerprint"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	leaf, err := parseLoadBalancerCertificate(
+		d.Get("certificate").(string), d.Get("private_key").(string), d.Get("chain").(string),
+	)
+	if err != nil {
+		return err
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return fmt.Errorf("certificate expired on %s", leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	for k, v := range flattenLoadBalancerCertificate(leaf) {
+		if err := d.SetNew(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateLoadBalancerSSL(ctx context.Context, client *govultr.Client, d *schema.ResourceData) error {
+	req := &govultr.LoadBalancerReq{
+		SSL: &govultr.SSL{
+			PrivateKey:  d.Get("private_key").(string),
+			Certificate: d.Get("certificate").(string),
+			Chain:       d.Get("chain").(string),
+		},
+	}
+
+	return client.LoadBalancer.Update(ctx, d.Get("load_balancer_id").(string), req)
+}
+
+// parseLoadBalancerCertificate returns the leaf certificate of the PEM
+// material. When a private key is given it has to belong to the certificate,
+// and when a chain is given it has to hold at least one certificate.
+func parseLoadBalancerCertificate(certificate, privateKey, chain string) (*x509.Certificate, error) {
+	var der []byte
+	if privateKey != "" {
+		pair, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate or private key: %v", err)
+		}
+		der = pair.Certificate[0]
+	} else {
+		block, _ := pem.Decode([]byte(certificate))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("certificate is not a PEM encoded certificate")
+		}
+		der = block.Bytes
+	}
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %v", err)
+	}
+
+	if chain != "" {
+		rest, count := []byte(chain), 0
+		for {
+			var block *pem.Block
+			if block, rest = pem.Decode(rest); block == nil {
+				break
+			}
+			if _, err := x509.ParseCertificate(block.Bytes); block.Type != "CERTIFICATE" || err != nil {
+				return nil, fmt.Errorf("chain holds an invalid certificate")
+			}
+			count++
+		}
+		if count == 0 {
+			return nil, fmt.Errorf("chain is not a PEM encoded certificate")
+		}
+	}
+
+	return leaf, nil
+}
+
+func flattenLoadBalancerCertificate(leaf *x509.Certificate) map[string]interface{} {
+	sans := append([]string{}, leaf.DNSNames...)
+	for _, ip := range leaf.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	fingerprint := sha256.Sum256(leaf.Raw)
+
+	return map[string]interface{}{
+		"not_before":                leaf.NotBefore.UTC().Format(time.RFC3339),
+		"not_after":                 leaf.NotAfter.UTC().Format(time.RFC3339),
+		"subject_alternative_names": sans,
+		"fingerprint":               hex.EncodeToString(fingerprint[:]),
+	}
+}
@@ -0,0 +1,145 @@
+package vultr
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrLoadBalancerSSLBasic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-lb-ssl")
+	name := "vultr_load_balancer_ssl.cert"
+	notAfter := time.Now().Add(90 * 24 * time.Hour)
+	cert, key := testCertificate(t, notAfter, "example.com", "www.example.com")
+	rotated, rotatedKey := testCertificate(t, notAfter.Add(24*time.Hour), "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerSSLConfig(rName, cert, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "not_after", notAfter.UTC().Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(name, "subject_alternative_names.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_load_balancer.lb", "has_ssl", "true"),
+				),
+			},
+			{
+				Config: testAccVultrLoadBalancerSSLConfig(rName, rotated, rotatedKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "subject_alternative_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseLoadBalancerCertificate(t *testing.T) {
+	cert, key := testCertificate(t, time.Now().Add(time.Hour), "example.com", "192.0.2.1")
+	_, otherKey := testCertificate(t, time.Now().Add(time.Hour), "example.net")
+
+	leaf, err := parseLoadBalancerCertificate(cert, key, cert)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sans := flattenLoadBalancerCertificate(leaf)["subject_alternative_names"].([]string)
+	if strings.Join(sans, ",") != "example.com,192.0.2.1" {
+		t.Errorf("unexpected subject alternative names %v", sans)
+	}
+
+	for name, tc := range map[string]struct {
+		cert, key, chain, err string
+	}{
+		"mismatched key": {cert: cert, key: otherKey, err: "private key does not match"},
+		"not a cert":     {cert: key, err: "not a PEM encoded certificate"},
+		"bad chain":      {cert: cert, key: key, chain: "chain", err: "chain is not a PEM encoded certificate"},
+		"key as chain":   {cert: cert, key: key, chain: key, err: "chain holds an invalid certificate"},
+	} {
+		_, err := parseLoadBalancerCertificate(tc.cert, tc.key, tc.chain)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.err, err)
+		}
+	}
+}
+
+// testCertificate returns a self signed PEM certificate and key for names,
+// which may be host names or IP addresses
+func testCertificate(t *testing.T, notAfter time.Time, names ...string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: names[0]},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour).Truncate(time.Second),
+		NotAfter:     notAfter.Truncate(time.Second),
+	}
+	for _, name := range names {
+		if ip := net.ParseIP(name); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, name)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func testAccVultrLoadBalancerSSLConfig(name, cert, key string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "lb" {
+			region = "ewr"
+			label  = "%s"
+
+			forwarding_rules {
+				frontend_protocol = "https"
+				frontend_port     = 443
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		resource "vultr_load_balancer_ssl" "cert" {
+			load_balancer_id = vultr_load_balancer.lb.id
+			certificate      = <<EOT
+%sEOT
+			private_key      = <<EOT
+%sEOT
+		}
+
+		data "vultr_load_balancer" "lb" {
+			filter {
+				name   = "label"
+				values = [vultr_load_balancer.lb.label]
+			}
+			depends_on = [vultr_load_balancer_ssl.cert]
+		}`, name, cert, key)
+}
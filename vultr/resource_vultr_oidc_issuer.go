@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -110,7 +109,7 @@ func resourceVultrOIDCIssuerRead(ctx context.Context, d *schema.ResourceData, me
 
 	issu, _, err := client.OIDC.GetOIDCIssuer(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Issuer Not Found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing oidc issuer (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
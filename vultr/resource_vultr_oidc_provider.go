@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -56,7 +55,7 @@ func resourceVultrOIDCProviderRead(ctx context.Context, d *schema.ResourceData,
 
 	prov, _, err := client.OIDC.GetOIDCProvider(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Provider Not Found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing oidc provider (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
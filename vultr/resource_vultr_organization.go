@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -64,7 +63,7 @@ func resourceVultrOrganizationRead(ctx context.Context, d *schema.ResourceData,
 
 	org, _, err := client.Organization.GetOrganization(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Organization not found.") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
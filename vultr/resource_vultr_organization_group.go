@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -106,7 +105,7 @@ func resourceVultrOrganizationGroupRead(ctx context.Context, d *schema.ResourceD
 
 	grp, _, err := client.Organization.GetGroup(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Group not found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization group (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
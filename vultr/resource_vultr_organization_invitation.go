@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -100,7 +99,7 @@ func resourceVultrOrganizationInvitationRead(ctx context.Context, d *schema.Reso
 
 	inv, _, err := client.Organization.GetInvitation(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invite not found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization invitation (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
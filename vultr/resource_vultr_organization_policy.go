@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -166,7 +165,7 @@ func resourceVultrOrganizationPolicyRead(ctx context.Context, d *schema.Resource
 
 	policy, _, err := client.Organization.GetPolicy(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Policy not found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization policy (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -102,7 +101,7 @@ func resourceVultrOrganizationRoleRead(ctx context.Context, d *schema.ResourceDa
 
 	role, _, err := client.Organization.GetRole(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Role not found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization role (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
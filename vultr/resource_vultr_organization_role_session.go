@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -115,7 +114,7 @@ func resourceVultrOrganizationRoleSessionRead(ctx context.Context, d *schema.Res
 
 	session, _, err := client.Organization.GetRoleSession(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Assumed Role Not Found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization role session (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
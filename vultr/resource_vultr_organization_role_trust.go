@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -114,7 +113,7 @@ func resourceVultrOrganizationRoleTrustRead(ctx context.Context, d *schema.Resou
 
 	trust, _, err := client.Organization.GetRoleTrust(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Role trust not found") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing organization role trust (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -77,7 +76,7 @@ func resourceVultrStartupScriptRead(ctx context.Context, d *schema.ResourceData,
 
 	script, _, err := client.StartupScript.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid startup script ID") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing startup script (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
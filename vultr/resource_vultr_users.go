@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -128,7 +127,7 @@ func resourceVultrUsersRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	user, _, err := client.User.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid user") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing user (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
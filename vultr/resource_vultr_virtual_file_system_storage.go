@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -114,6 +113,7 @@ func resourceVultrVirtualFileSystemStorageCreate(ctx context.Context, d *schema.
 			req.Tags = append(req.Tags, tags[i].(string))
 		}
 	}
+	req.Tags = meta.(*Client).mergeDefaultTags(req.Tags)
 
 	storage, _, err := client.VirtualFileSystemStorage.Create(ctx, &req)
 	if err != nil {
@@ -150,7 +150,7 @@ func resourceVultrVirtualFileSystemStorageRead(ctx context.Context, d *schema.Re
 
 	storage, _, err := client.VirtualFileSystemStorage.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Subscription ID Not Found.") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("removing virtual file system storage (%s) because it is gone", d.Id()))
 			d.SetId("")
 			return nil
@@ -167,7 +167,7 @@ func resourceVultrVirtualFileSystemStorageRead(ctx context.Context, d *schema.Re
 	if err := d.Set("label", storage.Label); err != nil {
 		return diag.Errorf("unable to set resource virtual_file_system_storage `label` read value: %v", err)
 	}
-	if err := d.Set("tags", storage.Tags); err != nil {
+	if err := d.Set("tags", meta.(*Client).resourceTags(d, storage.Tags)); err != nil {
 		return diag.Errorf("unable to set resource virtual_file_system_storage `tags` read value: %v", err)
 	}
 	if err := d.Set("date_created", storage.DateCreated); err != nil {
@@ -297,10 +297,14 @@ func resourceVultrVirtualFileSystemStorageDelete(ctx context.Context, d *schema.
 			return nil
 		}
 
-		if strings.Contains(err.Error(), "Can not delete this subscription until it is detatched from all machines") { //nolint:misspell,lll
+		if isConflictError(err) || apiErrorContains(err, "until it is detatched from all machines") { //nolint:misspell
 			return retry.RetryableError(fmt.Errorf("virtual file system storage is still attached: %s", err.Error()))
 		}
 
+		if isRetryableError(err) {
+			return retry.RetryableError(fmt.Errorf("deleting virtual file system storage failed with retryable error: %s", err.Error())) //nolint:lll
+		}
+
 		return retry.NonRetryableError(err)
 	})
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -83,7 +82,7 @@ func resourceVultrVPCRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	vpc, _, err := client.VPC.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid VPC ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Vultr VPC (%s) not found", d.Id())
 			d.SetId("")
 			return nil
@@ -132,10 +131,14 @@ func resourceVultrVPCDelete(ctx context.Context, d *schema.ResourceData, meta in
 			return nil
 		}
 
-		if strings.Contains(err.Error(), "attached to this VPC network") {
+		if isConflictError(err) || apiErrorContains(err, "attached to this VPC network") {
 			return retry.RetryableError(fmt.Errorf("cannot remove attached VPC: %s", err.Error()))
 		}
 
+		if isRetryableError(err) {
+			return retry.RetryableError(fmt.Errorf("deleting VPC failed with retryable error: %s", err.Error()))
+		}
+
 		return retry.NonRetryableError(err)
 	})
 
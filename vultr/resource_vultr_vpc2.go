@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -91,7 +90,7 @@ func resourceVultrVPC2Read(ctx context.Context, d *schema.ResourceData, meta int
 
 	vpc, _, err := client.VPC2.Get(ctx, d.Id()) //nolint:staticcheck
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid VPC 2.0 ID") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Vultr VPC 2.0 (%s) not found", d.Id())
 			d.SetId("")
 			return nil
@@ -140,10 +139,14 @@ func resourceVultrVPC2Delete(ctx context.Context, d *schema.ResourceData, meta i
 			return nil
 		}
 
-		if strings.Contains(err.Error(), "servers are attached to this VPC 2.0 network:") {
+		if isConflictError(err) || apiErrorContains(err, "servers are attached to this VPC 2.0 network:") {
 			return retry.RetryableError(fmt.Errorf("cannot remove attached VPC 2.0: %s", err.Error()))
 		}
 
+		if isRetryableError(err) {
+			return retry.RetryableError(fmt.Errorf("deleting VPC 2.0 failed with retryable error: %s", err.Error()))
+		}
+
 		return retry.NonRetryableError(err)
 	})
 
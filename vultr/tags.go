@@ -0,0 +1,97 @@
+package vultr
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTagsSchema is the provider level default_tags block
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags applied to every taggable resource managed by this provider",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// tagsAllSchema is the computed tags_all attribute of resources with a tags
+// set. It holds every tag sent to the API, the provider default tags
+// included.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// expandDefaultTags reads the tags out of the provider default_tags block
+func expandDefaultTags(d *schema.ResourceData) []string {
+	var tags []string
+	for _, v := range d.Get("default_tags.0.tags").(*schema.Set).List() {
+		tags = append(tags, v.(string))
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// mergeDefaultTags returns the resource tags with any provider default tags
+// the resource doesn't already set appended
+func (c *Client) mergeDefaultTags(tags []string) []string {
+	merged := append([]string{}, tags...)
+	return append(merged, diffSlice(tags, c.defaultTags)...)
+}
+
+// diffTagsAll plans tags_all from the resource tags and the current provider
+// default tags, so a change to default_tags shows up as an update of the
+// resources it applies to
+func (c *Client) diffTagsAll(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	var tags []string
+	for _, v := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, v.(string))
+	}
+
+	return d.SetNew("tags_all", c.mergeDefaultTags(tags))
+}
+
+// resourceTags strips the provider default tags from the tags returned by the
+// API, keeping any default tag that is also set on the resource itself
+func (c *Client) resourceTags(d *schema.ResourceData, apiTags []string) []string {
+	configured := map[string]bool{}
+	if v, ok := d.Get("tags").(*schema.Set); ok {
+		for _, tag := range v.List() {
+			configured[tag.(string)] = true
+		}
+	}
+
+	defaults := map[string]bool{}
+	for _, tag := range c.defaultTags {
+		defaults[tag] = true
+	}
+
+	tags := []string{}
+	for _, tag := range apiTags {
+		if defaults[tag] && !configured[tag] {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
@@ -0,0 +1,59 @@
+package vultr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []string
+		tags     []string
+		want     []string
+	}{
+		{name: "no defaults", tags: []string{"web"}, want: []string{"web"}},
+		{name: "no tags", defaults: []string{"team:infra"}, want: []string{"team:infra"}},
+		{name: "merged", defaults: []string{"cost:42", "team:infra"}, tags: []string{"web"}, want: []string{"web", "cost:42", "team:infra"}}, //nolint:lll
+		{name: "overlap", defaults: []string{"team:infra"}, tags: []string{"team:infra", "web"}, want: []string{"team:infra", "web"}},        //nolint:lll
+		{name: "empty", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{defaultTags: tt.defaults}
+			if got := c.mergeDefaultTags(tt.tags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeDefaultTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		defaults   []string
+		configured []interface{}
+		apiTags    []string
+		want       []string
+	}{
+		{name: "no defaults", configured: []interface{}{"web"}, apiTags: []string{"web"}, want: []string{"web"}},
+		{name: "defaults removed", defaults: []string{"team:infra"}, configured: []interface{}{"web"}, apiTags: []string{"web", "team:infra"}, want: []string{"web"}},               //nolint:lll
+		{name: "configured default kept", defaults: []string{"team:infra"}, configured: []interface{}{"team:infra"}, apiTags: []string{"team:infra"}, want: []string{"team:infra"}}, //nolint:lll
+		{name: "drift kept", defaults: []string{"team:infra"}, apiTags: []string{"manual", "team:infra"}, want: []string{"manual"}},                                                 //nolint:lll
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{defaultTags: tt.defaults}
+			d := resourceVultrInstance().TestResourceData()
+			if err := d.Set("tags", tt.configured); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := c.resourceTags(d, tt.apiTags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}